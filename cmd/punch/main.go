@@ -0,0 +1,146 @@
+// Command punch is the PUNCH static-analysis CLI for Rust codebases.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/analyzer"
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/metrics"
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/vulns"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "punch: "+err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: punch <analyze|serve> ...")
+	}
+
+	switch args[0] {
+	case "analyze":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: punch analyze <vulns|web> [flags]")
+		}
+		switch args[1] {
+		case "vulns":
+			return runAnalyzeVulns(args[2:])
+		case "web":
+			return runAnalyzeWeb(args[2:])
+		default:
+			return fmt.Errorf("unknown analyze subcommand %q", args[1])
+		}
+	case "serve":
+		return runServe(args[1:])
+	default:
+		return fmt.Errorf("usage: punch <analyze|serve> ...")
+	}
+}
+
+// runServe starts a long-lived daemon exposing Prometheus metrics for
+// every analyzer run it's asked to perform, in place of the CLI's normal
+// one-shot invocations.
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := fs.String("addr", ":9090", "address to serve /metrics on")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.DefaultRegistry.Handler())
+
+	log.Printf("punch serve: listening on %s (/metrics)", *addr)
+	return http.ListenAndServe(*addr, mux)
+}
+
+func runAnalyzeWeb(args []string) error {
+	fs := flag.NewFlagSet("analyze web", flag.ContinueOnError)
+	root := fs.String("root", ".", "crate root to scan")
+	emit := fs.String("emit", "", `output format: "openapi" to emit an OpenAPI 3.0 document, empty for a plain summary`)
+	title := fs.String("title", "API", "OpenAPI info.title")
+	version := fs.String("api-version", "0.1.0", "OpenAPI info.version")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	a := analyzer.NewWebFrameworkAnalyzer(*root)
+	a.Ctx = metrics.NewAnalyzerContext("web_frameworks", stderrProgress{})
+	report, err := a.Run()
+	if err != nil {
+		return fmt.Errorf("scanning %s: %w", *root, err)
+	}
+
+	switch *emit {
+	case "openapi":
+		fmt.Print(analyzer.RenderOpenAPI(report, *title, *version))
+	case "":
+		for name, fr := range report.ByFramework {
+			fmt.Printf("%s: %d routes, %d middleware, %d extractors\n",
+				name, len(fr.Routes), len(fr.Middleware), len(fr.Extractors))
+		}
+	default:
+		return fmt.Errorf("unknown --emit format %q", *emit)
+	}
+	return nil
+}
+
+// stderrProgress is the CLI's ProgressReporter: one line per file to
+// stderr, so stdout stays clean for piping analyzer output.
+type stderrProgress struct{}
+
+func (stderrProgress) Report(analyzer string, processed, total int, message string) {
+	fmt.Fprintf(os.Stderr, "[%s] %d/%d %s\n", analyzer, processed, total, message)
+}
+
+func runAnalyzeVulns(args []string) error {
+	fs := flag.NewFlagSet("analyze vulns", flag.ContinueOnError)
+	root := fs.String("root", ".", "crate root to scan")
+	advisoryDB := fs.String("advisory-db", "", "path to a JSON advisory dump (see vulns.LoadAdvisoryDB)")
+	asJSON := fs.Bool("json", false, "emit findings as JSON, suitable for CI gating")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *advisoryDB == "" {
+		return fmt.Errorf("--advisory-db is required")
+	}
+
+	db, err := vulns.LoadAdvisoryDB(*advisoryDB)
+	if err != nil {
+		return fmt.Errorf("loading advisory db: %w", err)
+	}
+
+	findings, err := vulns.NewScanner(*root, db).Run()
+	if err != nil {
+		return fmt.Errorf("scanning %s: %w", *root, err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(findings)
+	}
+
+	if len(findings) == 0 {
+		fmt.Println("no advisories matched locked dependencies")
+		return nil
+	}
+	for _, f := range findings {
+		reachability := "not observed reachable"
+		if f.Reachable {
+			reachability = "reachable"
+		}
+		fmt.Printf("%s  %s %s  severity=%s cvss=%.1f  %s\n",
+			f.Advisory.ID, f.Crate.Name, f.Crate.Version, f.Advisory.Severity, f.Advisory.CVSS, reachability)
+	}
+	return nil
+}
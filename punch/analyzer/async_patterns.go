@@ -0,0 +1,116 @@
+package analyzer
+
+import (
+	"os"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/metrics"
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rulelist"
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+// BlockingInAsyncFinding flags a call to a known blocking API from inside
+// an async fn, which stalls the executor thread it runs on.
+type BlockingInAsyncFinding struct {
+	File string
+	Fn   string
+	Line int
+	Call string
+}
+
+// AsyncPatternsReport is the combined output of an AsyncPatternsAnalyzer run.
+type AsyncPatternsReport struct {
+	AsyncFns      []string
+	AwaitCount    int
+	RuntimeMain   []string // files with a #[tokio::main] / #[async_std::main] entrypoint
+	BlockingCalls []BlockingInAsyncFinding
+}
+
+// defaultAsyncRules is the built-in rule set detecting known
+// thread-blocking calls made from inside an async fn. Blocking detection
+// used to be hardcoded Go logic; it is now just the default rulelist, so
+// a project can add its own anti-patterns via Rules without recompiling.
+const defaultAsyncRules = `id "PUNCH-ASYNC-001" severity=warn matches ast:Fn[async=true] contains ast:Call[path="std::thread::sleep"] message "blocking sleep in async fn"`
+
+// AsyncPatternsAnalyzer walks a Rust crate's async/await usage: which fns
+// are async, how many await points exist, what runtime entrypoint is in
+// play, and which anti-patterns (by default, known thread-blocking calls
+// made from an async fn) fire against each function, per Rules.
+type AsyncPatternsAnalyzer struct {
+	Root string
+	// Rules overrides the built-in blocking-call rule set. Nil uses
+	// defaultAsyncRules.
+	Rules []rulelist.Rule
+	// Ctx reports progress and metrics for this run. Nil is valid and
+	// disables instrumentation.
+	Ctx *metrics.AnalyzerContext
+}
+
+// NewAsyncPatternsAnalyzer returns an analyzer rooted at root, using the
+// built-in rule set.
+func NewAsyncPatternsAnalyzer(root string) *AsyncPatternsAnalyzer {
+	rules, _ := rulelist.Parse(defaultAsyncRules)
+	return &AsyncPatternsAnalyzer{Root: root, Rules: rules}
+}
+
+// Run parses every .rs file under Root with rustast and folds the results
+// into an AsyncPatternsReport.
+func (a *AsyncPatternsAnalyzer) Run() (*AsyncPatternsReport, error) {
+	report := &AsyncPatternsReport{}
+
+	files, err := findFiles(a.Root, ".rs")
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Ctx != nil {
+		defer a.Ctx.StartRun()()
+	}
+
+	for i, path := range files {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		if a.Ctx != nil {
+			a.Ctx.FileProcessed(i+1, len(files), path)
+		}
+
+		file := rustast.Parse(string(contents))
+		if a.Ctx != nil {
+			a.Ctx.NodesProcessed(len(file.Items))
+		}
+		for _, item := range file.Items {
+			fn, ok := item.(*rustast.Fn)
+			if !ok {
+				continue
+			}
+
+			for _, attr := range fn.Attrs {
+				if attr.Path == "tokio::main" || attr.Path == "async_std::main" || attr.Path == "main" {
+					report.RuntimeMain = append(report.RuntimeMain, path)
+				}
+			}
+
+			if !fn.IsAsync {
+				continue
+			}
+			report.AsyncFns = append(report.AsyncFns, path+"::"+fn.Name)
+			report.AwaitCount += len(fn.Awaits)
+		}
+
+		for _, match := range rulelist.Evaluate(file, a.Rules) {
+			handler := ""
+			if match.Fn != nil {
+				handler = match.Fn.Name
+			}
+			report.BlockingCalls = append(report.BlockingCalls, BlockingInAsyncFinding{
+				File: path, Fn: handler, Line: match.Span.Line, Call: match.Rule.ID,
+			})
+			if a.Ctx != nil {
+				a.Ctx.Finding(string(match.Rule.Severity))
+			}
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,173 @@
+package analyzer
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// AuthzBuilderCall is a call site that constructs a query through one of
+// the recognized authorization-aware query builders (QueryBuilder,
+// BoxedQuery, or a hand-rolled AuthorizeSQLFilter helper).
+type AuthzBuilderCall struct {
+	File      string
+	Line      int
+	Builder   string
+	HasFilter bool
+}
+
+// InMemoryAuthzFinding flags a fetch-then-authorize site: rows are loaded
+// from the database and only checked against the caller's roles/scopes
+// afterward, the classic N+1 authorization antipattern.
+type InMemoryAuthzFinding struct {
+	File string
+	Line int
+}
+
+// GuardedTable records which predicate shape (e.g. "owner_id = $1") was
+// seen guarding a table, so tables can be reported as guarded or ungated.
+type GuardedTable struct {
+	Table     string
+	Predicate string
+	File      string
+	Line      int
+}
+
+// AuthzFilterReport is the combined output of the SQL-authorization pass.
+type AuthzFilterReport struct {
+	UnguardedBuilders []AuthzBuilderCall
+	InMemoryChecks    []InMemoryAuthzFinding
+	GuardedTables     []GuardedTable
+}
+
+var (
+	queryBuilderCallPattern = regexp.MustCompile(`\b(sqlx::QueryBuilder|QueryBuilder)::new\s*\(`)
+	boxedQueryPattern       = regexp.MustCompile(`\bBoxedQuery\b`)
+	authzHelperPattern      = regexp.MustCompile(`\bAuthorizeSQLFilter\w*\s*\(`)
+	filterChainPattern      = regexp.MustCompile(`\.filter\s*\(\s*(?:roles|scopes|groups|auth|current_user|ctx\.user)`)
+
+	fetchCallPattern = regexp.MustCompile(`\b(?:fetch_all|load::<[^>]*>|query_as!?\s*\([^)]*\))\s*\(`)
+	authCheckPattern = regexp.MustCompile(`\b(?:if|\.retain\(|\.filter\()\s*[^;]*\b(?:role|scope|permission|can_access|authoriz)`)
+
+	// rowGuardPattern matches an ownership/tenant-scoping predicate, not
+	// just any parameterized equality (a primary-key lookup like
+	// `id = $1` is not a row-level authorization guard).
+	rowGuardPattern  = regexp.MustCompile(`\b(owner_id|organization_id|org_id|tenant_id|account_id|company_id|created_by)\s*=\s*(?:\$\d+|ANY\(\$\d+\))`)
+	tableNamePattern = regexp.MustCompile(`(?i)FROM\s+(\w+)`)
+)
+
+// AuthzFilterAnalyzer finds where row-level authorization filters are (or
+// are not) threaded through query construction, so missing per-row
+// visibility checks surface as potential authorization bypasses.
+type AuthzFilterAnalyzer struct {
+	Root string
+}
+
+// NewAuthzFilterAnalyzer returns an analyzer rooted at root.
+func NewAuthzFilterAnalyzer(root string) *AuthzFilterAnalyzer {
+	return &AuthzFilterAnalyzer{Root: root}
+}
+
+// Run scans every .rs file under Root.
+func (a *AuthzFilterAnalyzer) Run() (*AuthzFilterReport, error) {
+	report := &AuthzFilterReport{}
+
+	files, err := findFiles(a.Root, ".rs")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range files {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		text := string(contents)
+
+		a.findUnguardedBuilders(path, text, report)
+		a.findInMemoryChecks(path, text, report)
+		a.findGuardedTables(path, text, report)
+	}
+
+	return report, nil
+}
+
+// findUnguardedBuilders flags QueryBuilder/BoxedQuery/AuthorizeSQLFilter
+// call sites whose surrounding statement never chains a roles/scopes/groups
+// driven `.filter(...)`, i.e. queries built without an authorization
+// predicate threaded through.
+func (a *AuthzFilterAnalyzer) findUnguardedBuilders(path, text string, report *AuthzFilterReport) {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		var builder string
+		switch {
+		case queryBuilderCallPattern.MatchString(line):
+			builder = "QueryBuilder"
+		case boxedQueryPattern.MatchString(line):
+			builder = "BoxedQuery"
+		case authzHelperPattern.MatchString(line):
+			builder = "AuthorizeSQLFilter"
+		default:
+			continue
+		}
+
+		window := strings.Join(lines[i:minInt(i+6, len(lines))], "\n")
+		hasFilter := filterChainPattern.MatchString(window)
+
+		if !hasFilter {
+			report.UnguardedBuilders = append(report.UnguardedBuilders, AuthzBuilderCall{
+				File: path, Line: i + 1, Builder: builder, HasFilter: false,
+			})
+		}
+	}
+}
+
+// findInMemoryChecks flags a fetch followed shortly after (within a few
+// lines) by an authorization check performed in Rust rather than in SQL.
+func (a *AuthzFilterAnalyzer) findInMemoryChecks(path, text string, report *AuthzFilterReport) {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if !fetchCallPattern.MatchString(line) {
+			continue
+		}
+		window := strings.Join(lines[i:minInt(i+4, len(lines))], "\n")
+		if authCheckPattern.MatchString(window) {
+			report.InMemoryChecks = append(report.InMemoryChecks, InMemoryAuthzFinding{File: path, Line: i + 1})
+		}
+	}
+}
+
+// findGuardedTables recognizes common predicate shapes guarding a table
+// (owner_id = $1, organization_id = ANY($2), ...) and groups them so a
+// report can distinguish guarded tables from ungated ones.
+func (a *AuthzFilterAnalyzer) findGuardedTables(path, text string, report *AuthzFilterReport) {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		predMatch := rowGuardPattern.FindString(line)
+		if predMatch == "" {
+			continue
+		}
+		window := strings.Join(lines[maxInt(0, i-3):i+1], "\n")
+		table := ""
+		if m := tableNamePattern.FindStringSubmatch(window); m != nil {
+			table = m[1]
+		}
+		report.GuardedTables = append(report.GuardedTables, GuardedTable{
+			Table: table, Predicate: predMatch, File: path, Line: i + 1,
+		})
+	}
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
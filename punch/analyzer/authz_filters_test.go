@@ -0,0 +1,40 @@
+package analyzer
+
+import "testing"
+
+func TestRowGuardPatternIgnoresPrimaryKeyLookup(t *testing.T) {
+	if rowGuardPattern.MatchString(`sqlx::query("SELECT * FROM widgets WHERE id = $1")`) {
+		t.Fatalf("a primary-key lookup is not an ownership/tenant guard")
+	}
+	if !rowGuardPattern.MatchString(`sqlx::query("SELECT * FROM widgets WHERE owner_id = $1")`) {
+		t.Fatalf("owner_id = $1 should match as a row-level guard")
+	}
+}
+
+func TestFetchThenCheckOnlyMatchesNearbyAuthCheck(t *testing.T) {
+	nearby := `let rows = fetch_all(&pool).await?;
+if !can_access(&rows, &ctx.user) {
+    return Err(Forbidden);
+}`
+	analyzer := &AuthzFilterAnalyzer{}
+	report := &AuthzFilterReport{}
+	analyzer.findInMemoryChecks("test.rs", nearby, report)
+	if len(report.InMemoryChecks) != 1 {
+		t.Fatalf("want 1 finding for a nearby check, got %d", len(report.InMemoryChecks))
+	}
+
+	far := `let rows = fetch_all(&pool).await?;
+render(&rows);
+log_request();
+audit_trail();
+schedule_job();
+cleanup();
+if !can_access(&rows, &ctx.user) {
+    return Err(Forbidden);
+}`
+	report = &AuthzFilterReport{}
+	analyzer.findInMemoryChecks("test.rs", far, report)
+	if len(report.InMemoryChecks) != 0 {
+		t.Fatalf("an auth check many lines later should not be paired with the fetch, got %d", len(report.InMemoryChecks))
+	}
+}
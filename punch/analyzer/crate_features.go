@@ -0,0 +1,242 @@
+package analyzer
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// splitDriverCrates are the per-backend sqlx driver crates that exist
+// alongside the sqlx umbrella crate in the modern split-driver layout.
+var splitDriverCrates = map[string]DBBackend{
+	"sqlx-mysql":    BackendMySQL,
+	"sqlx-postgres": BackendPostgres,
+	"sqlx-sqlite":   BackendSQLite,
+}
+
+// runtimeFeatures and tlsFeatures are the orthogonal feature groups sqlx
+// (and crates following its convention) expose; exactly one of each is
+// meant to be enabled at a time.
+var (
+	runtimeFeatures = []string{"runtime-tokio", "runtime-async-std"}
+	tlsFeatures     = []string{"tls-native-tls", "tls-rustls", "tls-none"}
+)
+
+// SplitDriverFinding reports a crate's use of the per-backend driver crate
+// layout, where each database backend is vendored as its own crate and
+// wired together by the umbrella crate's Cargo features.
+type SplitDriverFinding struct {
+	File     string
+	Backends []DBBackend
+}
+
+// FeatureCombo is one concrete (runtime, tls, backend) combination that
+// Cargo feature unification can actually produce.
+type FeatureCombo struct {
+	Runtime string
+	TLS     string
+	Backend string
+}
+
+// FeatureConflict flags a Cargo `[features]` table that enables more than
+// one member of a feature group that should be mutually exclusive, without
+// declaring that exclusivity anywhere (sqlx does this via doc comments and
+// build.rs, not Cargo itself, so it's easy to get wrong downstream).
+type FeatureConflict struct {
+	File  string
+	Group []string
+}
+
+// RuntimeAPIWarning flags a call to a runtime-specific API (e.g.
+// tokio::spawn) in a crate whose Cargo.toml does not hard-require the
+// matching runtime feature, meaning the call site can silently fail to
+// compile (or compile against the wrong runtime) depending on which
+// features a downstream consumer selects.
+type RuntimeAPIWarning struct {
+	File    string
+	Line    int
+	API     string
+	Runtime string
+}
+
+// CrateFeatureReport is the combined output of a CrateFeatureAnalyzer run.
+type CrateFeatureReport struct {
+	SplitDrivers    []SplitDriverFinding
+	BuildableCombos []FeatureCombo
+	Conflicts       []FeatureConflict
+	RuntimeWarnings []RuntimeAPIWarning
+}
+
+// CrateFeatureAnalyzer recognizes the sqlx-style architecture where runtime,
+// TLS backend, and database backend are all wired together through
+// independent Cargo feature flags, and reports which combinations are
+// actually buildable.
+type CrateFeatureAnalyzer struct {
+	Root string
+}
+
+// NewCrateFeatureAnalyzer returns an analyzer rooted at root.
+func NewCrateFeatureAnalyzer(root string) *CrateFeatureAnalyzer {
+	return &CrateFeatureAnalyzer{Root: root}
+}
+
+var runtimeAPIPattern = regexp.MustCompile(`\b(tokio::spawn|tokio::task::spawn_blocking|async_std::task::spawn)\b`)
+
+// Run scans every Cargo.toml and .rs file under Root.
+func (a *CrateFeatureAnalyzer) Run() (*CrateFeatureReport, error) {
+	report := &CrateFeatureReport{}
+
+	cargoTomls, err := findFiles(a.Root, "Cargo.toml")
+	if err != nil {
+		return nil, err
+	}
+	rustFiles, err := findFiles(a.Root, ".rs")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range cargoTomls {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		text := string(contents)
+
+		var backends []DBBackend
+		for crate, backend := range splitDriverCrates {
+			if dependencyDeclared(text, crate) {
+				backends = append(backends, backend)
+			}
+		}
+		if len(backends) > 0 {
+			sort.Slice(backends, func(i, j int) bool { return backends[i] < backends[j] })
+			report.SplitDrivers = append(report.SplitDrivers, SplitDriverFinding{File: path, Backends: backends})
+		}
+
+		features := parseFeaturesTable(text)
+		activated := activatedFeatures(features)
+		enabledRuntimes := enabledMembers(activated, runtimeFeatures)
+		enabledTLS := enabledMembers(activated, tlsFeatures)
+
+		if len(enabledRuntimes) > 1 {
+			report.Conflicts = append(report.Conflicts, FeatureConflict{File: path, Group: enabledRuntimes})
+		}
+		if len(enabledTLS) > 1 {
+			report.Conflicts = append(report.Conflicts, FeatureConflict{File: path, Group: enabledTLS})
+		}
+
+		for _, rt := range orDefault(enabledRuntimes, runtimeFeatures) {
+			for _, tls := range orDefault(enabledTLS, tlsFeatures) {
+				for _, backend := range backends {
+					report.BuildableCombos = append(report.BuildableCombos, FeatureCombo{
+						Runtime: rt, TLS: tls, Backend: string(backend),
+					})
+				}
+			}
+		}
+
+	}
+
+	for _, path := range rustFiles {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		for lineNum, line := range strings.Split(string(contents), "\n") {
+			m := runtimeAPIPattern.FindString(line)
+			if m == "" {
+				continue
+			}
+			runtime := "runtime-tokio"
+			if strings.HasPrefix(m, "async_std") {
+				runtime = "runtime-async-std"
+			}
+			report.RuntimeWarnings = append(report.RuntimeWarnings, RuntimeAPIWarning{
+				File: path, Line: lineNum + 1, API: m, Runtime: runtime,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// featureTable maps a feature name to the list of other features/deps it
+// enables, as declared in a `[features]` table.
+type featureTable map[string][]string
+
+var featuresHeaderPattern = regexp.MustCompile(`(?m)^\[features\]\s*$`)
+var featureEntryPattern = regexp.MustCompile(`(?m)^(\w[\w-]*)\s*=\s*\[([^\]]*)\]`)
+
+func parseFeaturesTable(tomlText string) featureTable {
+	loc := featuresHeaderPattern.FindStringIndex(tomlText)
+	if loc == nil {
+		return nil
+	}
+	rest := tomlText[loc[1]:]
+	if next := regexp.MustCompile(`(?m)^\[`).FindStringIndex(rest); next != nil {
+		rest = rest[:next[0]]
+	}
+
+	table := featureTable{}
+	for _, m := range featureEntryPattern.FindAllStringSubmatch(rest, -1) {
+		name := m[1]
+		var deps []string
+		for _, d := range strings.Split(m[2], ",") {
+			d = strings.Trim(strings.TrimSpace(d), `"`)
+			if d != "" {
+				deps = append(deps, d)
+			}
+		}
+		table[name] = deps
+	}
+	return table
+}
+
+// activatedFeatures returns the set of feature names transitively enabled
+// by the crate's own `default` feature, expanding through the table's
+// dependency lists. Cargo always turns on default (absent an explicit
+// `--no-default-features` from a downstream consumer, which this static
+// view of one Cargo.toml can't see), so this is the set of runtime/TLS
+// members the crate itself actually selects; merely appearing as a
+// `[features]` table entry (e.g. `runtime-async-std = []` declared
+// alongside `runtime-tokio = []` as the other option a consumer can pick)
+// is not activation.
+func activatedFeatures(table featureTable) map[string]bool {
+	activated := map[string]bool{}
+	var walk func(name string)
+	walk = func(name string) {
+		if activated[name] {
+			return
+		}
+		activated[name] = true
+		for _, dep := range table[name] {
+			dep = strings.TrimPrefix(dep, "dep:")
+			if _, ok := table[dep]; ok {
+				walk(dep)
+			}
+		}
+	}
+	if _, ok := table["default"]; ok {
+		walk("default")
+	}
+	return activated
+}
+
+// enabledMembers returns which of candidates are actually activated.
+func enabledMembers(activated map[string]bool, candidates []string) []string {
+	var enabled []string
+	for _, c := range candidates {
+		if activated[c] {
+			enabled = append(enabled, c)
+		}
+	}
+	return enabled
+}
+
+func orDefault(values, fallback []string) []string {
+	if len(values) > 0 {
+		return values
+	}
+	return fallback
+}
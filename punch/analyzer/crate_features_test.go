@@ -0,0 +1,29 @@
+package analyzer
+
+import "testing"
+
+func TestEnabledMembersDeclaringBothRuntimesIsNotAConflict(t *testing.T) {
+	toml := `[features]
+default = ["runtime-tokio"]
+runtime-tokio = []
+runtime-async-std = []`
+
+	activated := activatedFeatures(parseFeaturesTable(toml))
+	enabled := enabledMembers(activated, runtimeFeatures)
+	if len(enabled) != 1 || enabled[0] != "runtime-tokio" {
+		t.Fatalf("want only the activated runtime-tokio, got %v", enabled)
+	}
+}
+
+func TestEnabledMembersFlagsDefaultEnablingBothRuntimes(t *testing.T) {
+	toml := `[features]
+default = ["runtime-tokio", "runtime-async-std"]
+runtime-tokio = []
+runtime-async-std = []`
+
+	activated := activatedFeatures(parseFeaturesTable(toml))
+	enabled := enabledMembers(activated, runtimeFeatures)
+	if len(enabled) != 2 {
+		t.Fatalf("want both runtimes activated via default, got %v", enabled)
+	}
+}
@@ -0,0 +1,271 @@
+// Package analyzer implements the PUNCH static-analysis passes that scan a
+// Rust crate tree for architectural patterns (database access, async usage,
+// web frameworks, traits, tests, ...) without executing any of the target
+// code.
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// DBBackend identifies a database backend recognized by DatabasePatternsAnalyzer.
+type DBBackend string
+
+const (
+	BackendMySQL    DBBackend = "mysql"
+	BackendSQLite   DBBackend = "sqlite"
+	BackendRedis    DBBackend = "redis"
+	BackendPostgres DBBackend = "postgres"
+)
+
+// Finding is a single pattern match reported for a non-Postgres backend.
+type Finding struct {
+	File   string
+	Line   int
+	Detail string
+}
+
+// PostgresFinding captures everything DatabasePatternsAnalyzer was able to
+// infer about a crate's use of a particular Postgres-capable framework.
+type PostgresFinding struct {
+	// Framework is the detected crate: sqlx, diesel, tokio-postgres,
+	// deadpool-postgres, or sea-orm.
+	Framework string
+	// PoolType is the connection-pool implementation in play, when one
+	// could be inferred (e.g. "deadpool", "bb8", "r2d2", "sqlx::PgPool").
+	PoolType string
+	// SSLMode is the sslmode value seen in a connection string, if any.
+	SSLMode string
+	// MigrationTooling names the migration mechanism found (e.g.
+	// "sqlx::migrate!", "diesel_migrations"), empty if none was seen.
+	MigrationTooling string
+	// UsesBigIntIDs is true when the crate declares BIGINT/BIGSERIAL id
+	// columns, which can silently overflow a Rust i32 if mapped wrong.
+	UsesBigIntIDs bool
+	// ConnectionStrings holds every connection-string literal matched,
+	// in either postgres:// URL form or space-delimited key=value form.
+	ConnectionStrings []string
+	File              string
+	Line              int
+}
+
+// DatabaseReport is the combined output of a DatabasePatternsAnalyzer run.
+type DatabaseReport struct {
+	MySQL    []Finding
+	SQLite   []Finding
+	Redis    []Finding
+	Postgres []PostgresFinding
+}
+
+// DatabasePatternsAnalyzer scans a Rust crate tree for database integration
+// patterns: which backends are in use, how connections are configured, and
+// what migration tooling (if any) manages schema changes.
+type DatabasePatternsAnalyzer struct {
+	// Root is the crate (or workspace) directory to scan.
+	Root string
+}
+
+// NewDatabasePatternsAnalyzer returns an analyzer rooted at root.
+func NewDatabasePatternsAnalyzer(root string) *DatabasePatternsAnalyzer {
+	return &DatabasePatternsAnalyzer{Root: root}
+}
+
+var (
+	mysqlDepPattern  = regexp.MustCompile(`(?m)^\s*(mysql|mysql_async|sqlx)\s*=`)
+	sqliteDepPattern = regexp.MustCompile(`(?m)^\s*(rusqlite|sqlx)\s*=`)
+	redisDepPattern  = regexp.MustCompile(`(?m)^\s*redis\s*=`)
+
+	// postgresDeps maps the crate name as it appears in Cargo.toml to the
+	// human-readable framework name surfaced in PostgresFinding.
+	postgresDeps = map[string]string{
+		"sqlx":              "sqlx",
+		"diesel":            "diesel",
+		"tokio-postgres":    "tokio-postgres",
+		"deadpool-postgres": "deadpool-postgres",
+		"sea-orm":           "sea-orm",
+	}
+
+	// postgresFeatureNames lists, for a multi-backend crate, the feature
+	// name(s) that select Postgres support. A crate absent from this map
+	// is postgres-only by name (tokio-postgres, deadpool-postgres) and
+	// needs no feature flag to be evidence of Postgres use.
+	postgresFeatureNames = map[string][]string{
+		"sqlx":    {"postgres"},
+		"diesel":  {"postgres"},
+		"sea-orm": {"sqlx-postgres", "postgres"},
+	}
+
+	postgresURLPattern   = regexp.MustCompile(`postgres(?:ql)?://[^\s"']+`)
+	postgresKVPattern    = regexp.MustCompile(`\b(?:host|hostaddr)=\S*\s+(?:\S+=\S*\s*)*user=\S+(?:\s+\S+=\S*)*`)
+	sslModePattern       = regexp.MustCompile(`sslmode=(\w+)`)
+	poolTypePattern      = regexp.MustCompile(`\b(deadpool(?:_postgres|-postgres)?|bb8|r2d2|sqlx::(?:Pg)?Pool|PgPool)\b`)
+	migrationSqlxPattern = regexp.MustCompile(`sqlx::migrate!`)
+	migrationDslPattern  = regexp.MustCompile(`diesel_migrations`)
+	bigIntColumnPattern  = regexp.MustCompile(`(?i)\b(BIGSERIAL|BIGINT)\b`)
+)
+
+// Run walks the crate tree and produces a DatabaseReport describing every
+// recognized backend.
+func (a *DatabasePatternsAnalyzer) Run() (*DatabaseReport, error) {
+	report := &DatabaseReport{}
+
+	cargoTomls, err := findFiles(a.Root, "Cargo.toml")
+	if err != nil {
+		return nil, err
+	}
+	rustFiles, err := findFiles(a.Root, ".rs")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range cargoTomls {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		text := string(contents)
+
+		if mysqlDepPattern.MatchString(text) {
+			report.MySQL = append(report.MySQL, Finding{File: path, Detail: "mysql dependency declared"})
+		}
+		if sqliteDepPattern.MatchString(text) {
+			report.SQLite = append(report.SQLite, Finding{File: path, Detail: "sqlite dependency declared"})
+		}
+		if redisDepPattern.MatchString(text) {
+			report.Redis = append(report.Redis, Finding{File: path, Detail: "redis dependency declared"})
+		}
+
+		for crate, framework := range postgresDeps {
+			if postgresDependencyDeclared(text, crate) {
+				report.Postgres = append(report.Postgres, PostgresFinding{
+					Framework: framework,
+					File:      path,
+				})
+			}
+		}
+	}
+
+	for _, path := range rustFiles {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		text := string(contents)
+		a.scanRustSourceForPostgres(path, text, report)
+	}
+
+	return report, nil
+}
+
+// scanRustSourceForPostgres folds connection-string, pool, migration, and
+// column-width evidence from a single Rust source file into the report's
+// existing Postgres findings (matched by framework where possible, else
+// appended as a framework-less finding).
+func (a *DatabasePatternsAnalyzer) scanRustSourceForPostgres(path, text string, report *DatabaseReport) {
+	var connStrings []string
+	connStrings = append(connStrings, postgresURLPattern.FindAllString(text, -1)...)
+	connStrings = append(connStrings, postgresKVPattern.FindAllString(text, -1)...)
+	if len(connStrings) == 0 && !bigIntColumnPattern.MatchString(text) &&
+		!migrationSqlxPattern.MatchString(text) && !migrationDslPattern.MatchString(text) {
+		return
+	}
+
+	finding := PostgresFinding{
+		File:              path,
+		ConnectionStrings: connStrings,
+		UsesBigIntIDs:     bigIntColumnPattern.MatchString(text),
+	}
+	if m := sslModePattern.FindStringSubmatch(text); m != nil {
+		finding.SSLMode = m[1]
+	}
+	if m := poolTypePattern.FindString(text); m != "" {
+		finding.PoolType = m
+	}
+	switch {
+	case migrationSqlxPattern.MatchString(text):
+		finding.MigrationTooling = "sqlx::migrate!"
+	case migrationDslPattern.MatchString(text):
+		finding.MigrationTooling = "diesel_migrations"
+	}
+
+	report.Postgres = append(report.Postgres, finding)
+}
+
+// dependencyDeclared reports whether crate is declared anywhere under a
+// [dependencies]-style table in a Cargo.toml.
+func dependencyDeclared(tomlText, crate string) bool {
+	pattern := regexp.MustCompile(`(?m)^\s*` + regexp.QuoteMeta(crate) + `\s*=`)
+	return pattern.MatchString(tomlText)
+}
+
+// postgresDependencyDeclared reports whether crate is declared as evidence
+// of Postgres use. Crates that speak more than one backend (sqlx, diesel,
+// sea-orm) are only counted when their postgres feature is actually
+// enabled (e.g. `sqlx = { version = "0.7", features = ["postgres"] }`);
+// a declaration with only `features = ["mysql"]` is not Postgres evidence.
+// Crates that are postgres-only by name need no feature check.
+func postgresDependencyDeclared(tomlText, crate string) bool {
+	if !dependencyDeclared(tomlText, crate) {
+		return false
+	}
+	wantFeatures, gated := postgresFeatureNames[crate]
+	if !gated {
+		return true
+	}
+	for _, f := range dependencyFeatures(tomlText, crate) {
+		for _, want := range wantFeatures {
+			if f == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dependencyFeatures extracts the `features = [...]` list from crate's
+// inline dependency table entry, e.g. the ["postgres", "runtime-tokio"]
+// in `sqlx = { version = "0.7", features = ["postgres", "runtime-tokio"] }`.
+// It does not see features set via a separate `[dependencies.crate]` table.
+func dependencyFeatures(tomlText, crate string) []string {
+	pattern := regexp.MustCompile(`(?m)^\s*` + regexp.QuoteMeta(crate) + `\s*=\s*\{[^}]*features\s*=\s*\[([^\]]*)\]`)
+	m := pattern.FindStringSubmatch(tomlText)
+	if m == nil {
+		return nil
+	}
+	var features []string
+	for _, f := range strings.Split(m[1], ",") {
+		f = strings.Trim(strings.TrimSpace(f), `"`)
+		if f != "" {
+			features = append(features, f)
+		}
+	}
+	return features
+}
+
+// findFiles returns every file under root matching suffix (a literal
+// filename such as "Cargo.toml", or an extension such as ".rs").
+func findFiles(root, suffix string) ([]string, error) {
+	var matches []string
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "target" || d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, suffix) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
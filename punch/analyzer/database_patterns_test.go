@@ -0,0 +1,25 @@
+package analyzer
+
+import "testing"
+
+func TestPostgresDependencyDeclaredRequiresPostgresFeature(t *testing.T) {
+	mysqlOnly := `[dependencies]
+sqlx = { version = "0.7", features = ["mysql"] }`
+	if postgresDependencyDeclared(mysqlOnly, "sqlx") {
+		t.Fatalf("sqlx with only the mysql feature should not count as postgres evidence")
+	}
+
+	postgres := `[dependencies]
+sqlx = { version = "0.7", features = ["postgres", "runtime-tokio"] }`
+	if !postgresDependencyDeclared(postgres, "sqlx") {
+		t.Fatalf("sqlx with the postgres feature should count as postgres evidence")
+	}
+}
+
+func TestPostgresDependencyDeclaredPostgresOnlyCrateNeedsNoFeature(t *testing.T) {
+	toml := `[dependencies]
+tokio-postgres = "0.7"`
+	if !postgresDependencyDeclared(toml, "tokio-postgres") {
+		t.Fatalf("tokio-postgres is postgres-only and needs no feature flag")
+	}
+}
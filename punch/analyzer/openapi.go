@@ -0,0 +1,110 @@
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RenderOpenAPI synthesizes an OpenAPI 3.0 document from a
+// WebFrameworkReport's detected routes. It walks the reverse direction of
+// a Swagger importer: instead of turning a spec into route definitions,
+// it turns statically-observed routes into a spec, so a crate using any
+// supported framework gets zero-annotation API docs.
+//
+// Output is hand-emitted YAML rather than produced by a generic YAML
+// marshaller, since the document shape here is simple and fixed.
+func RenderOpenAPI(report *WebFrameworkReport, title, version string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "openapi: 3.0.0\n")
+	fmt.Fprintf(&b, "info:\n  title: %s\n  version: %q\n", yamlScalar(title), version)
+	fmt.Fprintf(&b, "paths:\n")
+
+	grouped := groupRoutesByPath(report)
+	var paths []string
+	for p := range grouped {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	if len(paths) == 0 {
+		fmt.Fprintf(&b, "  {}\n")
+		return b.String()
+	}
+
+	for _, p := range paths {
+		fmt.Fprintf(&b, "  %s:\n", yamlKey(p))
+		for _, route := range grouped[p] {
+			method := strings.ToLower(route.Method)
+			if method == "" || method == "any" {
+				method = "get"
+			}
+			fmt.Fprintf(&b, "    %s:\n", method)
+			fmt.Fprintf(&b, "      operationId: %s\n", yamlScalar(route.Handler))
+			writeParameters(&b, report, route)
+			fmt.Fprintf(&b, "      responses:\n")
+			fmt.Fprintf(&b, "        '200':\n")
+			fmt.Fprintf(&b, "          description: %s response\n", yamlScalar(route.Handler))
+		}
+	}
+
+	return b.String()
+}
+
+func groupRoutesByPath(report *WebFrameworkReport) map[string][]Route {
+	grouped := map[string][]Route{}
+	for _, fr := range report.ByFramework {
+		for _, route := range fr.Routes {
+			if route.Path == "" {
+				continue
+			}
+			grouped[route.Path] = append(grouped[route.Path], route)
+		}
+	}
+	return grouped
+}
+
+func writeParameters(b *strings.Builder, report *WebFrameworkReport, route Route) {
+	axum, ok := report.ByFramework["axum"]
+	if !ok {
+		return
+	}
+	var params []Extractor
+	for _, e := range axum.Extractors {
+		if e.Handler == route.Handler {
+			params = append(params, e)
+		}
+	}
+	if len(params) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "      parameters:\n")
+	for _, p := range params {
+		in := "query"
+		switch p.Type {
+		case "Path":
+			in = "path"
+		case "Json", "Extension", "State":
+			continue // request body / app state, not a parameter
+		}
+		fmt.Fprintf(b, "        - name: %s\n", yamlScalar(p.Param))
+		fmt.Fprintf(b, "          in: %s\n", in)
+		fmt.Fprintf(b, "          required: true\n")
+	}
+}
+
+func yamlScalar(s string) string {
+	if s == "" {
+		return `""`
+	}
+	return s
+}
+
+func yamlKey(s string) string {
+	if strings.ContainsAny(s, ":#") || s == "" {
+		return fmt.Sprintf("%q", s)
+	}
+	return s
+}
@@ -0,0 +1,43 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderOpenAPIEmitsRealPathFromDetectedRoute(t *testing.T) {
+	report := &WebFrameworkReport{ByFramework: map[string]*FrameworkReport{
+		"axum": {
+			Framework: "axum",
+			Routes: []Route{
+				{Method: "ANY", Path: "/users/:id", Handler: "get_user"},
+			},
+		},
+	}}
+
+	out := RenderOpenAPI(report, "Demo API", "1.0.0")
+
+	if strings.Contains(out, "paths:\n  {}\n") {
+		t.Fatalf("want a non-empty paths section, got:\n%s", out)
+	}
+	if !strings.Contains(out, "/users/:id") {
+		t.Fatalf("want the real route path in the rendered spec, got:\n%s", out)
+	}
+	if !strings.Contains(out, "operationId: get_user") {
+		t.Fatalf("want the handler as operationId, got:\n%s", out)
+	}
+}
+
+func TestRenderOpenAPIOmitsRoutesWithNoPath(t *testing.T) {
+	report := &WebFrameworkReport{ByFramework: map[string]*FrameworkReport{
+		"actix": {
+			Framework: "actix",
+			Routes:    []Route{{Method: "ANY", Handler: "unknown"}},
+		},
+	}}
+
+	out := RenderOpenAPI(report, "Demo API", "1.0.0")
+	if !strings.Contains(out, "paths:\n  {}\n") {
+		t.Fatalf("want an empty paths section for a route with no resolvable path, got:\n%s", out)
+	}
+}
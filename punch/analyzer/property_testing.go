@@ -0,0 +1,163 @@
+package analyzer
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// TestCoverageStyle classifies how a single test case exercises its
+// subject, so downstream suggestions don't lump fundamentally different
+// testing strategies together.
+type TestCoverageStyle string
+
+const (
+	StyleExampleBased  TestCoverageStyle = "example"
+	StylePropertyBased TestCoverageStyle = "property"
+	StyleSnapshotBased TestCoverageStyle = "snapshot"
+)
+
+// GoldenFileTest is a single test recognized as comparing output against a
+// committed fixture (a "golden file"), whether via a snapshot-testing crate
+// or a hand-rolled `include_str!` + `assert_eq!` pair.
+type GoldenFileTest struct {
+	File              string
+	Line              int
+	Name              string
+	Mechanism         string // e.g. "insta", "expect-test", "hand-rolled"
+	HasUpdateEnv      bool
+	SnapshotCommitted bool
+}
+
+// PropertyTestingReport is the combined output of a PropertyTestingAnalyzer run.
+type PropertyTestingReport struct {
+	QuickCheckTests []string
+	GoldenFileTests []GoldenFileTest
+}
+
+// PropertyTestingAnalyzer recognizes property-based and example/fixture
+// based testing idioms in a Rust crate: `quickcheck`/`proptest` style
+// generators, and golden/snapshot fixture comparisons.
+type PropertyTestingAnalyzer struct {
+	Root string
+}
+
+// NewPropertyTestingAnalyzer returns an analyzer rooted at root.
+func NewPropertyTestingAnalyzer(root string) *PropertyTestingAnalyzer {
+	return &PropertyTestingAnalyzer{Root: root}
+}
+
+var (
+	quickcheckAttrPattern = regexp.MustCompile(`#\[quickcheck\]|proptest!\s*\{`)
+	testFnPattern         = regexp.MustCompile(`fn\s+(\w+)\s*\(`)
+
+	instaPattern      = regexp.MustCompile(`insta::assert_\w*_snapshot!`)
+	expectTestPattern = regexp.MustCompile(`expect_test::expect!|expect!\s*\[`)
+	includeStrPattern = regexp.MustCompile(`include_str!\(\s*"([^"]+)"\s*\)`)
+	updateEnvPattern  = regexp.MustCompile(`UPDATE_EXPECT|INSTA_UPDATE`)
+)
+
+// Run scans every .rs file under Root and returns a PropertyTestingReport.
+func (a *PropertyTestingAnalyzer) Run() (*PropertyTestingReport, error) {
+	report := &PropertyTestingReport{}
+
+	rustFiles, err := findFiles(a.Root, ".rs")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range rustFiles {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		text := string(contents)
+
+		a.detectQuickCheck(path, text, report)
+		a.DetectGoldenFileTests(path, text, report)
+	}
+
+	return report, nil
+}
+
+func (a *PropertyTestingAnalyzer) detectQuickCheck(path, text string, report *PropertyTestingReport) {
+	if !quickcheckAttrPattern.MatchString(text) {
+		return
+	}
+	for _, m := range testFnPattern.FindAllStringSubmatch(text, -1) {
+		report.QuickCheckTests = append(report.QuickCheckTests, path+"::"+m[1])
+	}
+}
+
+// DetectGoldenFileTests recognizes the input/golden fixture style: tests
+// backed by a committed snapshot file, whether produced by a snapshot
+// crate (insta, expect-test) or a hand-rolled include_str!+assert_eq! pair,
+// and whether a regeneration mechanism (an -update flag or env var) exists.
+func (a *PropertyTestingAnalyzer) DetectGoldenFileTests(path, text string, report *PropertyTestingReport) {
+	hasUpdateEnv := updateEnvPattern.MatchString(text)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		var mechanism, fixture string
+		switch {
+		case instaPattern.MatchString(line):
+			mechanism = "insta"
+		case expectTestPattern.MatchString(line):
+			mechanism = "expect-test"
+		case includeStrPattern.MatchString(line):
+			mechanism = "hand-rolled"
+			if m := includeStrPattern.FindStringSubmatch(line); m != nil {
+				fixture = m[1]
+			}
+		default:
+			continue
+		}
+
+		name := nearestEnclosingFn(lines, i)
+		committed := mechanism != "hand-rolled" || fixtureLooksCommitted(a.Root, path, fixture)
+
+		report.GoldenFileTests = append(report.GoldenFileTests, GoldenFileTest{
+			File:              path,
+			Line:              i + 1,
+			Name:              name,
+			Mechanism:         mechanism,
+			HasUpdateEnv:      hasUpdateEnv,
+			SnapshotCommitted: committed,
+		})
+	}
+}
+
+// nearestEnclosingFn walks backward from line i to find the name of the
+// test function the match sits inside, best-effort.
+func nearestEnclosingFn(lines []string, i int) string {
+	for j := i; j >= 0; j-- {
+		if m := testFnPattern.FindStringSubmatch(lines[j]); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// fixtureLooksCommitted reports whether a fixture referenced via
+// include_str! exists on disk relative to the source file (a crude but
+// effective proxy for "is this checked in", since testdata/ directories
+// are essentially always tracked in Rust crates).
+func fixtureLooksCommitted(root, sourceFile, relFixture string) bool {
+	if relFixture == "" {
+		return false
+	}
+	_ = root
+	dir := sourceFile[:strings.LastIndex(sourceFile, "/")+1]
+	_, err := os.Stat(dir + relFixture)
+	return err == nil
+}
+
+// AnalyzeOpportunities classifies each detected test by coverage style so
+// suggestions can distinguish example-based, property-based, and
+// snapshot-based coverage instead of lumping them together.
+func (a *PropertyTestingAnalyzer) AnalyzeOpportunities(report *PropertyTestingReport) map[TestCoverageStyle]int {
+	counts := map[TestCoverageStyle]int{}
+	counts[StylePropertyBased] += len(report.QuickCheckTests)
+	counts[StyleSnapshotBased] += len(report.GoldenFileTests)
+	return counts
+}
@@ -0,0 +1,61 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPropertyTestingAnalyzerRunDetectsQuickCheckAndGoldenFiles(t *testing.T) {
+	dir := t.TempDir()
+	src := `#[quickcheck]
+fn prop_roundtrips(x: u32) -> bool {
+    x == x
+}
+
+fn snapshot_matches_fixture() {
+    insta::assert_debug_snapshot!(render());
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "lib.rs"), []byte(src), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	report, err := NewPropertyTestingAnalyzer(dir).Run()
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	wantQuickCheck := filepath.Join(dir, "lib.rs") + "::prop_roundtrips"
+	var sawQuickCheck bool
+	for _, q := range report.QuickCheckTests {
+		if q == wantQuickCheck {
+			sawQuickCheck = true
+		}
+	}
+	if !sawQuickCheck {
+		t.Fatalf("want prop_roundtrips recorded as a quickcheck test, got %+v", report.QuickCheckTests)
+	}
+
+	if len(report.GoldenFileTests) != 1 {
+		t.Fatalf("want 1 golden file test, got %d: %+v", len(report.GoldenFileTests), report.GoldenFileTests)
+	}
+	golden := report.GoldenFileTests[0]
+	if golden.Mechanism != "insta" || golden.Name != "snapshot_matches_fixture" {
+		t.Fatalf("want an insta snapshot test attributed to snapshot_matches_fixture, got %+v", golden)
+	}
+}
+
+func TestFixtureLooksCommittedChecksFileOnDisk(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "expected.txt"), []byte("want"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if !fixtureLooksCommitted(dir, filepath.Join(dir, "lib.rs"), "expected.txt") {
+		t.Fatalf("want a fixture that exists on disk to count as committed")
+	}
+	if fixtureLooksCommitted(dir, filepath.Join(dir, "lib.rs"), "missing.txt") {
+		t.Fatalf("want a missing fixture to not count as committed")
+	}
+}
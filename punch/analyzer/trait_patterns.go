@@ -0,0 +1,253 @@
+package analyzer
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// AdapterBackend is the inferred storage/transport technology behind a
+// trait implementor, guessed from its module path and imports.
+type AdapterBackend string
+
+const (
+	AdapterPostgres AdapterBackend = "postgres"
+	AdapterMongo    AdapterBackend = "mongo"
+	AdapterRedis    AdapterBackend = "redis"
+	AdapterInMemory AdapterBackend = "inmemory"
+	AdapterUnknown  AdapterBackend = "unknown"
+)
+
+// Implementor is one `impl Trait for Type` site, classified by backend.
+type Implementor struct {
+	File    string
+	Line    int
+	Type    string
+	Module  string
+	Backend AdapterBackend
+}
+
+// Port is a trait declaration together with every adapter that implements
+// it, per the hexagonal ("ports and adapters") architecture idiom.
+type Port struct {
+	Trait        string
+	DeclaredFile string
+	Adapters     []Implementor
+}
+
+// LeakyAbstraction reports on a Port that looks like unnecessary
+// indirection (only one adapter ever implements it) or that has no
+// in-memory fake to unit test against.
+type LeakyAbstraction struct {
+	Trait         string
+	SingleAdapter bool
+	NoTestAdapter bool
+}
+
+// SearchFilter is a `*SearchFilter`-shaped struct passed to a `Search`
+// method, together with which adapters were seen honoring each field.
+type SearchFilter struct {
+	Name   string
+	File   string
+	Fields []string
+	// HonoredByAdapter maps adapter type name to the subset of Fields it
+	// was observed referencing in its Search implementation body.
+	HonoredByAdapter map[string][]string
+}
+
+// TraitPatternsReport is the combined output of a trait-patterns analysis.
+type TraitPatternsReport struct {
+	Traits            []string
+	Ports             []Port
+	LeakyAbstractions []LeakyAbstraction
+	SearchFilters     []SearchFilter
+}
+
+var (
+	traitDeclPattern  = regexp.MustCompile(`(?m)^\s*(?:pub\s+)?trait\s+(\w+)`)
+	implBlockPattern  = regexp.MustCompile(`(?m)^\s*impl(?:<[^>]*>)?\s+(\w+)(?:<[^>]*>)?\s+for\s+(\w+)`)
+	modPathPattern    = regexp.MustCompile(`(?m)^\s*(?:pub\s+)?mod\s+(\w+)`)
+	structDeclPattern = regexp.MustCompile(`(?m)(?:pub\s+)?struct\s+(\w*(?:Search)?Filter\w*)\s*\{([^}]*)\}`)
+	fieldNamePattern  = regexp.MustCompile(`(?m)^\s*(?:pub\s+)?(\w+)\s*:`)
+	searchFnPattern   = regexp.MustCompile(`fn\s+search\s*\([^)]*\)\s*(?:->[^{]*)?\{([^}]*(?:\{[^}]*\}[^}]*)*)\}`)
+)
+
+var backendImportHints = map[string]AdapterBackend{
+	"sqlx":     AdapterPostgres,
+	"diesel":   AdapterPostgres,
+	"postgres": AdapterPostgres,
+	"mongodb":  AdapterMongo,
+	"redis":    AdapterRedis,
+}
+
+// TraitPatternsAnalyzer detects trait/implementor graphs in a Rust crate,
+// including the ports-and-adapters idiom and the sibling search-filter
+// struct idiom.
+type TraitPatternsAnalyzer struct {
+	Root string
+}
+
+// NewTraitPatternsAnalyzer returns an analyzer rooted at root.
+func NewTraitPatternsAnalyzer(root string) *TraitPatternsAnalyzer {
+	return &TraitPatternsAnalyzer{Root: root}
+}
+
+// Run scans every .rs file under Root.
+func (a *TraitPatternsAnalyzer) Run() (*TraitPatternsReport, error) {
+	report := &TraitPatternsReport{}
+
+	files, err := findFiles(a.Root, ".rs")
+	if err != nil {
+		return nil, err
+	}
+
+	ports := map[string]*Port{}
+	fileText := map[string]string{}
+
+	for _, path := range files {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		text := string(contents)
+		fileText[path] = text
+
+		a.DetectTraitPatterns(path, text, report, ports)
+	}
+
+	for _, port := range ports {
+		report.Ports = append(report.Ports, *port)
+		report.LeakyAbstractions = append(report.LeakyAbstractions, classifyLeakiness(*port))
+	}
+
+	for path, text := range fileText {
+		report.SearchFilters = append(report.SearchFilters, a.DetectSearchFilters(path, text, fileText)...)
+	}
+
+	return report, nil
+}
+
+// DetectTraitPatterns records every trait declaration in text and, via
+// DetectPortsAndAdapters, every impl block that implements one of them.
+func (a *TraitPatternsAnalyzer) DetectTraitPatterns(path, text string, report *TraitPatternsReport, ports map[string]*Port) {
+	for _, m := range traitDeclPattern.FindAllStringSubmatch(text, -1) {
+		name := m[1]
+		report.Traits = append(report.Traits, name)
+		if _, ok := ports[name]; !ok {
+			ports[name] = &Port{Trait: name, DeclaredFile: path}
+		}
+	}
+
+	a.DetectPortsAndAdapters(path, text, ports)
+}
+
+// DetectPortsAndAdapters identifies `impl Trait for Type` sites and
+// classifies each implementor by its inferred backend, built from the
+// enclosing module path and nearby `use` imports.
+func (a *TraitPatternsAnalyzer) DetectPortsAndAdapters(path, text string, ports map[string]*Port) {
+	module := enclosingModuleGuess(path, text)
+	backend := inferBackend(module, text)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		m := implBlockPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		traitName, typeName := m[1], m[2]
+		port, ok := ports[traitName]
+		if !ok {
+			port = &Port{Trait: traitName}
+			ports[traitName] = port
+		}
+		port.Adapters = append(port.Adapters, Implementor{
+			File: path, Line: i + 1, Type: typeName, Module: module, Backend: backend,
+		})
+	}
+}
+
+// enclosingModuleGuess returns the last `mod` segment declared before the
+// first impl block in text, falling back to the file's own module-ish
+// directory name (mirroring `postgres::UserRepo` style path segments).
+func enclosingModuleGuess(path, text string) string {
+	if m := modPathPattern.FindStringSubmatch(text); m != nil {
+		return m[1]
+	}
+	segments := strings.Split(strings.TrimSuffix(path, ".rs"), "/")
+	if len(segments) >= 2 {
+		return segments[len(segments)-2]
+	}
+	return ""
+}
+
+func inferBackend(module, text string) AdapterBackend {
+	lowerModule := strings.ToLower(module)
+	switch {
+	case strings.Contains(lowerModule, "postgres") || strings.Contains(lowerModule, "pg"):
+		return AdapterPostgres
+	case strings.Contains(lowerModule, "mongo"):
+		return AdapterMongo
+	case strings.Contains(lowerModule, "redis"):
+		return AdapterRedis
+	case strings.Contains(lowerModule, "memory") || strings.Contains(lowerModule, "fake") || strings.Contains(lowerModule, "mock"):
+		return AdapterInMemory
+	}
+	for crate, backend := range backendImportHints {
+		if strings.Contains(text, crate) {
+			return backend
+		}
+	}
+	return AdapterUnknown
+}
+
+func classifyLeakiness(port Port) LeakyAbstraction {
+	hasInMemory := false
+	for _, adapter := range port.Adapters {
+		if adapter.Backend == AdapterInMemory {
+			hasInMemory = true
+		}
+	}
+	return LeakyAbstraction{
+		Trait:         port.Trait,
+		SingleAdapter: len(port.Adapters) == 1,
+		NoTestAdapter: !hasInMemory,
+	}
+}
+
+// DetectSearchFilters finds `*SearchFilter`-shaped structs and reports,
+// per adapter's `search` method in the rest of the crate, which filter
+// fields it actually references versus silently ignores.
+func (a *TraitPatternsAnalyzer) DetectSearchFilters(path, text string, allFiles map[string]string) []SearchFilter {
+	var filters []SearchFilter
+
+	for _, m := range structDeclPattern.FindAllStringSubmatch(text, -1) {
+		name, body := m[1], m[2]
+		var fields []string
+		for _, fm := range fieldNamePattern.FindAllStringSubmatch(body, -1) {
+			fields = append(fields, fm[1])
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		honored := map[string][]string{}
+		for otherPath, otherText := range allFiles {
+			for _, sm := range searchFnPattern.FindAllStringSubmatch(otherText, -1) {
+				body := sm[1]
+				var used []string
+				for _, field := range fields {
+					if strings.Contains(body, field) {
+						used = append(used, field)
+					}
+				}
+				if len(used) > 0 {
+					honored[otherPath] = used
+				}
+			}
+		}
+
+		filters = append(filters, SearchFilter{Name: name, File: path, Fields: fields, HonoredByAdapter: honored})
+	}
+
+	return filters
+}
@@ -0,0 +1,77 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTraitPatternsAnalyzerRunClassifiesLeakyPort(t *testing.T) {
+	dir := t.TempDir()
+	src := `trait UserRepo {
+    fn find(&self, id: u64);
+}
+
+mod postgres;
+
+impl UserRepo for PostgresUserRepo {
+    fn find(&self, id: u64) {}
+}
+`
+	if err := os.WriteFile(filepath.Join(dir, "repo.rs"), []byte(src), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	report, err := NewTraitPatternsAnalyzer(dir).Run()
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if len(report.Ports) != 1 {
+		t.Fatalf("want 1 port, got %d: %+v", len(report.Ports), report.Ports)
+	}
+	port := report.Ports[0]
+	if port.Trait != "UserRepo" || len(port.Adapters) != 1 || port.Adapters[0].Type != "PostgresUserRepo" {
+		t.Fatalf("want UserRepo implemented once by PostgresUserRepo, got %+v", port)
+	}
+	if port.Adapters[0].Backend != AdapterPostgres {
+		t.Fatalf("want the adapter classified as postgres, got %q", port.Adapters[0].Backend)
+	}
+
+	if len(report.LeakyAbstractions) != 1 {
+		t.Fatalf("want 1 leaky abstraction verdict, got %d", len(report.LeakyAbstractions))
+	}
+	leak := report.LeakyAbstractions[0]
+	if !leak.SingleAdapter || !leak.NoTestAdapter {
+		t.Fatalf("want a single-adapter port with no in-memory fake flagged leaky, got %+v", leak)
+	}
+}
+
+func TestDetectSearchFiltersTracksHonoredFields(t *testing.T) {
+	dir := t.TempDir()
+	allFiles := map[string]string{
+		filepath.Join(dir, "filter.rs"): `struct UserSearchFilter {
+    pub name: String,
+    pub age: u32,
+}`,
+		filepath.Join(dir, "repo.rs"): `impl UserRepo {
+    fn search(&self, filter: UserSearchFilter) {
+        if self.name == filter.name {}
+    }
+}`,
+	}
+
+	a := NewTraitPatternsAnalyzer(dir)
+	filters := a.DetectSearchFilters(filepath.Join(dir, "filter.rs"), allFiles[filepath.Join(dir, "filter.rs")], allFiles)
+	if len(filters) != 1 {
+		t.Fatalf("want 1 search filter, got %d: %+v", len(filters), filters)
+	}
+	filter := filters[0]
+	if filter.Name != "UserSearchFilter" {
+		t.Fatalf("want UserSearchFilter, got %q", filter.Name)
+	}
+	honored := filter.HonoredByAdapter[filepath.Join(dir, "repo.rs")]
+	if len(honored) != 1 || honored[0] != "name" {
+		t.Fatalf("want only 'name' honored by repo.rs's search, got %+v", honored)
+	}
+}
@@ -0,0 +1,59 @@
+package analyzer
+
+import "github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+
+// actixDetector recognizes actix-web's attribute-macro routes
+// (`#[get("/users")]`) and its builder-chain routing
+// (`App::new().route(...)`, `web::scope(...)`, `.wrap(...)`).
+type actixDetector struct{}
+
+func (actixDetector) Name() string { return "actix" }
+
+func (actixDetector) Detect(file *rustast.File, src, path string) *FrameworkReport {
+	if !importsCrate(src, "actix_web") {
+		return nil
+	}
+
+	report := &FrameworkReport{Framework: "actix"}
+	for _, item := range file.Items {
+		fn, ok := item.(*rustast.Fn)
+		if !ok {
+			continue
+		}
+
+		for _, attr := range fn.Attrs {
+			if method, ok := httpMethodAttrs[attr.Path]; ok {
+				report.Routes = append(report.Routes, Route{
+					Method: method, Path: unquoteStringLiteral(attr.Tokens), Handler: fn.Name,
+					File: path, Line: attr.Span.Line,
+				})
+			}
+		}
+
+		for _, call := range fn.Calls {
+			switch call.Method {
+			case "route":
+				routePath, _ := splitRouteArgs(call.Args)
+				report.Routes = append(report.Routes, Route{
+					Method: "ANY", Path: routePath, Handler: fn.Name, File: path, Line: call.Span.Line,
+				})
+			case "wrap":
+				report.Middleware = append(report.Middleware, Middleware{
+					Name: call.Args, File: path, Line: call.Span.Line,
+				})
+			}
+		}
+	}
+
+	if len(report.Routes) == 0 && len(report.Middleware) == 0 {
+		return nil
+	}
+	return report
+}
+
+// httpMethodAttrs maps an attribute-macro route's name to its HTTP
+// method. Shared by the actix and rocket detectors since both frameworks
+// use the same attribute spelling (`#[get("/x")]`).
+var httpMethodAttrs = map[string]string{
+	"get": "GET", "post": "POST", "put": "PUT", "delete": "DELETE", "patch": "PATCH", "head": "HEAD",
+}
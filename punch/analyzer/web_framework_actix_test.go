@@ -0,0 +1,43 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+func TestActixDetectorReportsRealAttributeRoutePath(t *testing.T) {
+	src := "use actix_web::get;\n\n#[get(\"/users/{id}\")]\nasync fn get_user() {}\n"
+	file := rustast.Parse(src)
+
+	report := actixDetector{}.Detect(file, src, "handlers.rs")
+	if report == nil {
+		t.Fatalf("want a report, got nil")
+	}
+	if len(report.Routes) != 1 {
+		t.Fatalf("want 1 route, got %d: %+v", len(report.Routes), report.Routes)
+	}
+	route := report.Routes[0]
+	if route.Method != "GET" || route.Path != "/users/{id}" || route.Handler != "get_user" {
+		t.Fatalf("want GET /users/{id} -> get_user, got %+v", route)
+	}
+}
+
+func TestActixDetectorReportsRealBuilderRoutePath(t *testing.T) {
+	src := `use actix_web::App;
+fn configure() {
+    App::new().route("/users/{id}", web::get().to(get_user));
+}`
+	file := rustast.Parse(src)
+
+	report := actixDetector{}.Detect(file, src, "main.rs")
+	if report == nil {
+		t.Fatalf("want a report, got nil")
+	}
+	if len(report.Routes) != 1 {
+		t.Fatalf("want 1 route, got %d: %+v", len(report.Routes), report.Routes)
+	}
+	if report.Routes[0].Path != "/users/{id}" {
+		t.Fatalf("want path /users/{id}, got %q", report.Routes[0].Path)
+	}
+}
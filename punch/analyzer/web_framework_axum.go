@@ -0,0 +1,97 @@
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+// axumDetector recognizes Axum's builder-chain routing
+// (`Router::new().route("/x", get(handler))`), `.layer(...)` middleware,
+// and `Path<T>`/`Query<T>`/`Json<T>` extractors on handler signatures.
+type axumDetector struct{}
+
+func (axumDetector) Name() string { return "axum" }
+
+var axumExtractorTypes = []string{"Path", "Query", "Json", "Extension", "State"}
+
+func (axumDetector) Detect(file *rustast.File, src, path string) *FrameworkReport {
+	if !importsCrate(src, "axum") {
+		return nil
+	}
+
+	report := &FrameworkReport{Framework: "axum"}
+	for _, item := range file.Items {
+		fn, ok := item.(*rustast.Fn)
+		if !ok {
+			continue
+		}
+
+		for _, call := range fn.Calls {
+			switch call.Method {
+			case "route":
+				routePath, handler := splitRouteArgs(call.Args)
+				report.Routes = append(report.Routes, Route{
+					Method: "ANY", Path: routePath, Handler: handler, File: path, Line: call.Span.Line,
+				})
+			case "layer":
+				report.Middleware = append(report.Middleware, Middleware{
+					Name: call.Args, File: path, Line: call.Span.Line,
+				})
+			}
+		}
+	}
+
+	// Extractors are declared in a handler's parameter list, which the
+	// lightweight rustast scanner does not model as structured params
+	// today; fall back to a source-text scan for `Type<...>` shapes near
+	// each handler's name.
+	report.Extractors = scanAxumExtractors(src, path)
+
+	if len(report.Routes) == 0 && len(report.Middleware) == 0 && len(report.Extractors) == 0 {
+		return nil
+	}
+	return report
+}
+
+// scanAxumExtractors looks for `Path<...>`, `Query<...>`, `Json<...>`,
+// `Extension<...>`, `State<...>` in handler signatures throughout src.
+func scanAxumExtractors(src, path string) []Extractor {
+	var found []Extractor
+	lines := strings.Split(src, "\n")
+	for i, line := range lines {
+		if !strings.Contains(line, "fn ") {
+			continue
+		}
+		handler := handlerNameFromSignature(line)
+		for _, t := range axumExtractorTypes {
+			idx := strings.Index(line, t+"<")
+			if idx == -1 {
+				continue
+			}
+			close := strings.Index(line[idx:], ">")
+			if close == -1 {
+				continue
+			}
+			found = append(found, Extractor{
+				Type: t, Param: line[idx : idx+close+1], Handler: handler, File: path, Line: i + 1,
+			})
+		}
+	}
+	return found
+}
+
+// handlerNameFromSignature extracts the function name from a line
+// containing `fn <name>(...)`, best-effort.
+func handlerNameFromSignature(line string) string {
+	idx := strings.Index(line, "fn ")
+	if idx == -1 {
+		return ""
+	}
+	rest := strings.TrimSpace(line[idx+3:])
+	end := strings.IndexAny(rest, "(< ")
+	if end == -1 {
+		return rest
+	}
+	return rest[:end]
+}
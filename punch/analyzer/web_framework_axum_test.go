@@ -0,0 +1,27 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+func TestAxumDetectorReportsRealRoutePathWithGroupedImport(t *testing.T) {
+	src := `use axum::{Router, routing::get};
+fn app() -> Router {
+    Router::new().route("/users/:id", get(list_users))
+}`
+	file := rustast.Parse(src)
+
+	report := axumDetector{}.Detect(file, src, "main.rs")
+	if report == nil {
+		t.Fatalf("want a report, got nil")
+	}
+	if len(report.Routes) != 1 {
+		t.Fatalf("want 1 route, got %d: %+v", len(report.Routes), report.Routes)
+	}
+	route := report.Routes[0]
+	if route.Path != "/users/:id" {
+		t.Fatalf("want path /users/:id, got %q", route.Path)
+	}
+}
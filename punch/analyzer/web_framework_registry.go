@@ -0,0 +1,123 @@
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+// Route is a single detected HTTP route.
+type Route struct {
+	Method  string
+	Path    string
+	Handler string
+	// Guards are extractor/guard tokens attached to the route (e.g. a
+	// Rocket request guard type, an Axum extractor, a Warp filter this
+	// route is `.and()`-ed with).
+	Guards []string
+	File   string
+	Line   int
+}
+
+// Middleware is a detected middleware/layer registration.
+type Middleware struct {
+	Name string
+	File string
+	Line int
+}
+
+// Extractor is a detected request-extraction type used by a handler
+// (e.g. Axum's `Path<T>`, `Query<T>`, `Json<T>`).
+type Extractor struct {
+	Type    string
+	Param   string
+	Handler string
+	File    string
+	Line    int
+}
+
+// FrameworkReport is one detector's normalized findings for a single
+// source file.
+type FrameworkReport struct {
+	Framework  string
+	Routes     []Route
+	Middleware []Middleware
+	Extractors []Extractor
+}
+
+// FrameworkDetector recognizes one web framework's idioms in a parsed
+// Rust source file. Implementations should return nil when the file
+// shows no evidence of the framework (checked via an import/path usage,
+// not just a coincidentally-matching method name).
+type FrameworkDetector interface {
+	Name() string
+	Detect(file *rustast.File, src, path string) *FrameworkReport
+}
+
+var detectorRegistry = map[string]FrameworkDetector{}
+
+// RegisterDetector adds d to the set of detectors WebFrameworkAnalyzer
+// runs against every source file. Intended to be called from an init()
+// in the package providing d, so third parties can add support for a
+// framework PUNCH doesn't ship a detector for.
+func RegisterDetector(d FrameworkDetector) {
+	detectorRegistry[d.Name()] = d
+}
+
+// Detectors returns every registered detector.
+func Detectors() []FrameworkDetector {
+	out := make([]FrameworkDetector, 0, len(detectorRegistry))
+	for _, d := range detectorRegistry {
+		out = append(out, d)
+	}
+	return out
+}
+
+func init() {
+	RegisterDetector(actixDetector{})
+	RegisterDetector(axumDetector{})
+	RegisterDetector(rocketDetector{})
+	RegisterDetector(warpDetector{})
+	RegisterDetector(tideDetector{})
+}
+
+// importsCrate reports whether src references a path rooted at crate,
+// e.g. importsCrate(src, "axum") matches both `use axum::Router` and a
+// fully-qualified `axum::Router::new()`.
+func importsCrate(src, crate string) bool {
+	for _, usage := range rustast.FindPathUsages(src) {
+		if usage.Path == crate || len(usage.Path) > len(crate) && usage.Path[:len(crate)+2] == crate+"::" {
+			return true
+		}
+	}
+	return false
+}
+
+// unquoteStringLiteral strips a Rust string literal's quoting down to its
+// content, e.g. `"/users/:id"` -> `/users/:id`, `b"/x"` -> `/x`. It is
+// used to turn an attribute argument or call argument's raw token text
+// (as captured by rustast) into the plain route path a detector reports.
+// Input that isn't a quoted literal is returned unchanged.
+func unquoteStringLiteral(s string) string {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "b")
+	s = strings.TrimPrefix(s, "r")
+	return strings.Trim(s, `"#`)
+}
+
+// splitRouteArgs splits a two-argument route-registration call's raw
+// argument text (as joined by rustast, e.g. `"/users/:id" , get ( list_users )`)
+// into its leading string-literal path and the remaining handler
+// expression. Shared by detectors whose framework spells a route as
+// `.route(path, handler)` (axum, actix's builder form).
+func splitRouteArgs(args string) (routePath, handler string) {
+	parts := strings.SplitN(args, ",", 2)
+	if len(parts) == 0 {
+		return "", ""
+	}
+	routePath = unquoteStringLiteral(parts[0])
+	if len(parts) > 1 {
+		handler = strings.TrimSpace(parts[1])
+	}
+	return routePath, handler
+}
@@ -0,0 +1,37 @@
+package analyzer
+
+import "testing"
+
+func TestImportsCrateDetectsGroupedImport(t *testing.T) {
+	if !importsCrate(`use axum::{Router, routing::get};`, "axum") {
+		t.Fatalf("want grouped import of axum to be detected")
+	}
+}
+
+func TestImportsCrateDetectsUngroupedImport(t *testing.T) {
+	if !importsCrate(`use axum::Router;`, "axum") {
+		t.Fatalf("want ungrouped import of axum to be detected")
+	}
+}
+
+func TestImportsCrateRejectsUnrelatedCrate(t *testing.T) {
+	if importsCrate(`use rocket::get;`, "axum") {
+		t.Fatalf("want no false positive for an unrelated crate")
+	}
+}
+
+func TestUnquoteStringLiteralStripsQuotes(t *testing.T) {
+	if got := unquoteStringLiteral(`"/users/:id"`); got != "/users/:id" {
+		t.Fatalf("want /users/:id, got %q", got)
+	}
+}
+
+func TestSplitRouteArgsExtractsPathAndHandler(t *testing.T) {
+	routePath, handler := splitRouteArgs(`"/users/:id" , get ( list_users )`)
+	if routePath != "/users/:id" {
+		t.Fatalf("want path /users/:id, got %q", routePath)
+	}
+	if handler != "get ( list_users )" {
+		t.Fatalf("want handler expression preserved, got %q", handler)
+	}
+}
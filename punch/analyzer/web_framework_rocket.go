@@ -0,0 +1,45 @@
+package analyzer
+
+import "github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+
+// rocketDetector recognizes Rocket's `#[get("/x")]`-style attribute
+// routes and `rocket::build().mount(...)` wiring.
+type rocketDetector struct{}
+
+func (rocketDetector) Name() string { return "rocket" }
+
+func (rocketDetector) Detect(file *rustast.File, src, path string) *FrameworkReport {
+	if !importsCrate(src, "rocket") {
+		return nil
+	}
+
+	report := &FrameworkReport{Framework: "rocket"}
+	for _, item := range file.Items {
+		fn, ok := item.(*rustast.Fn)
+		if !ok {
+			continue
+		}
+
+		for _, attr := range fn.Attrs {
+			if method, ok := httpMethodAttrs[attr.Path]; ok {
+				report.Routes = append(report.Routes, Route{
+					Method: method, Path: unquoteStringLiteral(attr.Tokens), Handler: fn.Name,
+					File: path, Line: attr.Span.Line,
+				})
+			}
+		}
+
+		for _, call := range fn.Calls {
+			if call.Method == "mount" {
+				report.Middleware = append(report.Middleware, Middleware{
+					Name: "mount(" + call.Args + ")", File: path, Line: call.Span.Line,
+				})
+			}
+		}
+	}
+
+	if len(report.Routes) == 0 && len(report.Middleware) == 0 {
+		return nil
+	}
+	return report
+}
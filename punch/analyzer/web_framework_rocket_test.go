@@ -0,0 +1,24 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+func TestRocketDetectorReportsRealAttributeRoutePath(t *testing.T) {
+	src := "use rocket::get;\n\n#[get(\"/users/<id>\")]\nfn get_user() {}\n"
+	file := rustast.Parse(src)
+
+	report := rocketDetector{}.Detect(file, src, "handlers.rs")
+	if report == nil {
+		t.Fatalf("want a report, got nil")
+	}
+	if len(report.Routes) != 1 {
+		t.Fatalf("want 1 route, got %d: %+v", len(report.Routes), report.Routes)
+	}
+	route := report.Routes[0]
+	if route.Method != "GET" || route.Path != "/users/<id>" || route.Handler != "get_user" {
+		t.Fatalf("want GET /users/<id> -> get_user, got %+v", route)
+	}
+}
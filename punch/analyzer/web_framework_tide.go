@@ -0,0 +1,51 @@
+package analyzer
+
+import "github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+
+// tideDetector recognizes Tide's `app.at("/path").get(handler)` chain
+// style. `.at(...)` and the following HTTP-verb call land as consecutive
+// entries in rustast's per-fn call list, so pairing adjacent calls
+// recovers the (path, method, handler) triple.
+type tideDetector struct{}
+
+func (tideDetector) Name() string { return "tide" }
+
+var tideVerbs = map[string]string{
+	"get": "GET", "post": "POST", "put": "PUT", "delete": "DELETE", "patch": "PATCH",
+}
+
+func (tideDetector) Detect(file *rustast.File, src, path string) *FrameworkReport {
+	if !importsCrate(src, "tide") {
+		return nil
+	}
+
+	report := &FrameworkReport{Framework: "tide"}
+	for _, item := range file.Items {
+		fn, ok := item.(*rustast.Fn)
+		if !ok {
+			continue
+		}
+
+		for i, call := range fn.Calls {
+			if call.Method != "at" {
+				continue
+			}
+			routePath := unquoteStringLiteral(call.Args)
+			if i+1 < len(fn.Calls) {
+				if method, ok := tideVerbs[fn.Calls[i+1].Method]; ok {
+					report.Routes = append(report.Routes, Route{
+						Method: method, Path: routePath, Handler: fn.Calls[i+1].Args,
+						File: path, Line: call.Span.Line,
+					})
+					continue
+				}
+			}
+			report.Routes = append(report.Routes, Route{Path: routePath, File: path, Line: call.Span.Line})
+		}
+	}
+
+	if len(report.Routes) == 0 {
+		return nil
+	}
+	return report
+}
@@ -0,0 +1,27 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+func TestTideDetectorReportsRealRoutePath(t *testing.T) {
+	src := `use tide::Server;
+fn routes(mut app: Server<()>) {
+    app.at("/users/:id").get(get_user);
+}`
+	file := rustast.Parse(src)
+
+	report := tideDetector{}.Detect(file, src, "main.rs")
+	if report == nil {
+		t.Fatalf("want a report, got nil")
+	}
+	if len(report.Routes) != 1 {
+		t.Fatalf("want 1 route, got %d: %+v", len(report.Routes), report.Routes)
+	}
+	route := report.Routes[0]
+	if route.Method != "GET" || route.Path != "/users/:id" {
+		t.Fatalf("want GET /users/:id, got %+v", route)
+	}
+}
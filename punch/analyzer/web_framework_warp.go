@@ -0,0 +1,61 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+// warpDetector recognizes Warp's filter-combinator routing style:
+// `warp::path("users")`, `warp::get()`/`warp::post()`, chained with
+// `.and(...)`/`.map(...)`. Warp builds routes by composing filters rather
+// than declaring a single route call, so unlike the other detectors this
+// records each path/method fragment independently rather than a fully
+// resolved Route. warp::path and warp::get/post are free functions
+// (`crate::fn(...)`), not method calls, so they are pulled straight out
+// of the source text rather than from rustast's `.method()` call list.
+type warpDetector struct{}
+
+func (warpDetector) Name() string { return "warp" }
+
+var (
+	warpPathCallPattern   = regexp.MustCompile(`warp::path\s*\(\s*"([^"]*)"\s*\)`)
+	warpMethodCallPattern = regexp.MustCompile(`warp::(get|post|put|delete|patch)\s*\(\s*\)`)
+)
+
+func (warpDetector) Detect(file *rustast.File, src, path string) *FrameworkReport {
+	if !importsCrate(src, "warp") {
+		return nil
+	}
+
+	report := &FrameworkReport{Framework: "warp"}
+	lines := strings.Split(src, "\n")
+	for i, line := range lines {
+		if m := warpPathCallPattern.FindStringSubmatch(line); m != nil {
+			report.Routes = append(report.Routes, Route{Path: m[1], File: path, Line: i + 1})
+		}
+		if m := warpMethodCallPattern.FindStringSubmatch(line); m != nil {
+			report.Routes = append(report.Routes, Route{Method: strings.ToUpper(m[1]), File: path, Line: i + 1})
+		}
+	}
+
+	for _, item := range file.Items {
+		fn, ok := item.(*rustast.Fn)
+		if !ok {
+			continue
+		}
+		for _, call := range fn.Calls {
+			if call.Method == "and" {
+				report.Routes = append(report.Routes, Route{
+					Guards: []string{call.Args}, Handler: fn.Name, File: path, Line: call.Span.Line,
+				})
+			}
+		}
+	}
+
+	if len(report.Routes) == 0 {
+		return nil
+	}
+	return report
+}
@@ -0,0 +1,74 @@
+package analyzer
+
+import (
+	"os"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/metrics"
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+// WebFrameworkReport is the combined output of a WebFrameworkAnalyzer run,
+// one FrameworkReport per framework that had at least one detection hit.
+type WebFrameworkReport struct {
+	ByFramework map[string]*FrameworkReport
+}
+
+// WebFrameworkAnalyzer walks a Rust crate's web-framework usage, running
+// every registered FrameworkDetector (see RegisterDetector) against each
+// parsed source file and merging their normalized findings.
+type WebFrameworkAnalyzer struct {
+	Root string
+	// Ctx reports progress and metrics for this run. Nil is valid and
+	// disables instrumentation.
+	Ctx *metrics.AnalyzerContext
+}
+
+// NewWebFrameworkAnalyzer returns an analyzer rooted at root.
+func NewWebFrameworkAnalyzer(root string) *WebFrameworkAnalyzer {
+	return &WebFrameworkAnalyzer{Root: root}
+}
+
+// Run parses every .rs file under Root with rustast and hands it to every
+// registered FrameworkDetector.
+func (a *WebFrameworkAnalyzer) Run() (*WebFrameworkReport, error) {
+	report := &WebFrameworkReport{ByFramework: map[string]*FrameworkReport{}}
+
+	files, err := findFiles(a.Root, ".rs")
+	if err != nil {
+		return nil, err
+	}
+
+	if a.Ctx != nil {
+		defer a.Ctx.StartRun()()
+	}
+
+	detectors := Detectors()
+	for i, path := range files {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		if a.Ctx != nil {
+			a.Ctx.FileProcessed(i+1, len(files), path)
+		}
+		src := string(contents)
+		file := rustast.Parse(src)
+
+		for _, d := range detectors {
+			found := d.Detect(file, src, path)
+			if found == nil {
+				continue
+			}
+			existing, ok := report.ByFramework[d.Name()]
+			if !ok {
+				report.ByFramework[d.Name()] = found
+				continue
+			}
+			existing.Routes = append(existing.Routes, found.Routes...)
+			existing.Middleware = append(existing.Middleware, found.Middleware...)
+			existing.Extractors = append(existing.Extractors, found.Extractors...)
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,30 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWebFrameworkAnalyzerRunDetectsAxumRoute(t *testing.T) {
+	dir := t.TempDir()
+	src := `use axum::{Router, routing::get};
+fn app() -> Router {
+    Router::new().route("/users/:id", get(list_users))
+}`
+	if err := os.WriteFile(filepath.Join(dir, "main.rs"), []byte(src), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	report, err := NewWebFrameworkAnalyzer(dir).Run()
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	axum, ok := report.ByFramework["axum"]
+	if !ok {
+		t.Fatalf("want an axum report, got %+v", report.ByFramework)
+	}
+	if len(axum.Routes) != 1 || axum.Routes[0].Path != "/users/:id" {
+		t.Fatalf("want a route for /users/:id, got %+v", axum.Routes)
+	}
+}
@@ -0,0 +1,78 @@
+package metrics
+
+import "time"
+
+// ProgressReporter receives incremental progress updates from a running
+// analyzer. The CLI implementation just prints them; a future LSP
+// frontend can turn them into a $/progress notification instead.
+type ProgressReporter interface {
+	Report(analyzer string, processed, total int, message string)
+}
+
+// NopProgressReporter discards every report. It is the default when no
+// reporter is supplied, so analyzers never need a nil check.
+type NopProgressReporter struct{}
+
+// Report implements ProgressReporter by doing nothing.
+func (NopProgressReporter) Report(string, int, int, string) {}
+
+var (
+	analyzerDuration = NewHistogramVec(DefaultRegistry,
+		"punch_analyzer_duration_seconds", "Analyzer run duration in seconds.", "analyzer", DefaultDurationBuckets)
+	filesProcessed = NewCounterVec(DefaultRegistry,
+		"punch_analyzer_files_processed_total", "Files processed by an analyzer.", "analyzer")
+	nodesProcessed = NewCounterVec(DefaultRegistry,
+		"punch_analyzer_nodes_processed_total", "AST nodes processed by an analyzer.", "analyzer")
+	inFlightJobs = NewGaugeVec(DefaultRegistry,
+		"punch_analyzer_in_flight", "Analyzer runs currently executing.", "analyzer")
+	findingsBySeverity = NewCounterVec(DefaultRegistry,
+		"punch_analyzer_findings_total", "Findings emitted, by severity.", "severity")
+)
+
+// AnalyzerContext carries everything an analyzer needs to report its own
+// progress and metrics instead of calling log.Fatal or fmt.Println: a
+// name (the metrics label), a progress sink, and a handle on the shared
+// metric collectors above.
+type AnalyzerContext struct {
+	Analyzer string
+	Progress ProgressReporter
+}
+
+// NewAnalyzerContext returns a context for the named analyzer. A nil
+// progress reporter is replaced with NopProgressReporter.
+func NewAnalyzerContext(analyzer string, progress ProgressReporter) *AnalyzerContext {
+	if progress == nil {
+		progress = NopProgressReporter{}
+	}
+	return &AnalyzerContext{Analyzer: analyzer, Progress: progress}
+}
+
+// StartRun marks the analyzer as in-flight and returns a func to call
+// when the run finishes, which records its duration and clears the
+// in-flight gauge.
+func (c *AnalyzerContext) StartRun() func() {
+	inFlightJobs.Inc(c.Analyzer)
+	start := time.Now()
+	return func() {
+		analyzerDuration.Observe(c.Analyzer, time.Since(start).Seconds())
+		inFlightJobs.Dec(c.Analyzer)
+	}
+}
+
+// FileProcessed records that one more file was scanned and reports
+// progress if a total is known (pass total=0 when it isn't).
+func (c *AnalyzerContext) FileProcessed(processed, total int, path string) {
+	filesProcessed.Inc(c.Analyzer)
+	c.Progress.Report(c.Analyzer, processed, total, path)
+}
+
+// NodesProcessed records that n more AST nodes were visited.
+func (c *AnalyzerContext) NodesProcessed(n int) {
+	nodesProcessed.Add(c.Analyzer, float64(n))
+}
+
+// Finding records one emitted finding of the given severity (e.g. "warn",
+// "error", "info").
+func (c *AnalyzerContext) Finding(severity string) {
+	findingsBySeverity.Inc(severity)
+}
@@ -0,0 +1,213 @@
+// Package metrics is a small, dependency-free Prometheus exposition
+// implementation: counters, gauges, and histograms, each with a single
+// label, rendered in the text format `/metrics` endpoints are expected to
+// serve. It exists so `punch serve` can expose real metrics without
+// pulling in github.com/prometheus/client_golang, which this tree has no
+// way to vendor.
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// collector is anything that can render itself in Prometheus text
+// exposition format.
+type collector interface {
+	writeProm(b *strings.Builder)
+}
+
+// Registry holds every collector that should be served on /metrics.
+type Registry struct {
+	mu         sync.Mutex
+	collectors []collector
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) register(c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectors = append(r.collectors, c)
+}
+
+// Handler returns an http.Handler suitable for mounting at /metrics.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		r.mu.Lock()
+		cs := append([]collector(nil), r.collectors...)
+		r.mu.Unlock()
+
+		var b strings.Builder
+		for _, c := range cs {
+			c.writeProm(&b)
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		_, _ = w.Write([]byte(b.String()))
+	})
+}
+
+// DefaultRegistry is the registry `punch serve` exposes by default.
+var DefaultRegistry = NewRegistry()
+
+// CounterVec is a monotonically increasing counter keyed by one label.
+type CounterVec struct {
+	name, help, label string
+	mu                sync.Mutex
+	values            map[string]float64
+}
+
+// NewCounterVec creates and registers a CounterVec against r.
+func NewCounterVec(r *Registry, name, help, label string) *CounterVec {
+	c := &CounterVec{name: name, help: help, label: label, values: map[string]float64{}}
+	r.register(c)
+	return c
+}
+
+// Inc increments the counter for labelValue by 1.
+func (c *CounterVec) Inc(labelValue string) { c.Add(labelValue, 1) }
+
+// Add increments the counter for labelValue by delta.
+func (c *CounterVec) Add(labelValue string, delta float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[labelValue] += delta
+}
+
+func (c *CounterVec) writeProm(b *strings.Builder) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s counter\n", c.name, c.help, c.name)
+	for _, lv := range sortedKeys(c.values) {
+		fmt.Fprintf(b, "%s{%s=%q} %v\n", c.name, c.label, lv, c.values[lv])
+	}
+}
+
+// GaugeVec is a value that can go up or down, keyed by one label.
+type GaugeVec struct {
+	name, help, label string
+	mu                sync.Mutex
+	values            map[string]float64
+}
+
+// NewGaugeVec creates and registers a GaugeVec against r.
+func NewGaugeVec(r *Registry, name, help, label string) *GaugeVec {
+	g := &GaugeVec{name: name, help: help, label: label, values: map[string]float64{}}
+	r.register(g)
+	return g
+}
+
+// Inc increments the gauge for labelValue by 1.
+func (g *GaugeVec) Inc(labelValue string) { g.Add(labelValue, 1) }
+
+// Dec decrements the gauge for labelValue by 1.
+func (g *GaugeVec) Dec(labelValue string) { g.Add(labelValue, -1) }
+
+// Add adds delta to the gauge for labelValue.
+func (g *GaugeVec) Add(labelValue string, delta float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values[labelValue] += delta
+}
+
+// Set sets the gauge for labelValue to v.
+func (g *GaugeVec) Set(labelValue string, v float64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values[labelValue] = v
+}
+
+func (g *GaugeVec) writeProm(b *strings.Builder) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s gauge\n", g.name, g.help, g.name)
+	for _, lv := range sortedKeys(g.values) {
+		fmt.Fprintf(b, "%s{%s=%q} %v\n", g.name, g.label, lv, g.values[lv])
+	}
+}
+
+// DefaultDurationBuckets mirrors Prometheus's own default histogram
+// buckets, in seconds.
+var DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type histogramState struct {
+	counts []uint64 // counts[i] = observations <= buckets[i]
+	sum    float64
+	count  uint64
+}
+
+// HistogramVec observes durations (or any float measurement) into fixed
+// buckets, keyed by one label.
+type HistogramVec struct {
+	name, help, label string
+	buckets           []float64
+	mu                sync.Mutex
+	states            map[string]*histogramState
+}
+
+// NewHistogramVec creates and registers a HistogramVec against r.
+func NewHistogramVec(r *Registry, name, help, label string, buckets []float64) *HistogramVec {
+	h := &HistogramVec{name: name, help: help, label: label, buckets: buckets, states: map[string]*histogramState{}}
+	r.register(h)
+	return h
+}
+
+// Observe records v against labelValue.
+func (h *HistogramVec) Observe(labelValue string, v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.states[labelValue]
+	if !ok {
+		s = &histogramState{counts: make([]uint64, len(h.buckets))}
+		h.states[labelValue] = s
+	}
+	for i, bound := range h.buckets {
+		if v <= bound {
+			s.counts[i]++
+		}
+	}
+	s.sum += v
+	s.count++
+}
+
+func (h *HistogramVec) writeProm(b *strings.Builder) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s histogram\n", h.name, h.help, h.name)
+	for _, lv := range sortedKeys(stateKeys(h.states)) {
+		s := h.states[lv]
+		for i, bound := range h.buckets {
+			fmt.Fprintf(b, "%s_bucket{%s=%q,le=%q} %d\n", h.name, h.label, lv, formatBound(bound), s.counts[i])
+		}
+		fmt.Fprintf(b, "%s_bucket{%s=%q,le=\"+Inf\"} %d\n", h.name, h.label, lv, s.count)
+		fmt.Fprintf(b, "%s_sum{%s=%q} %v\n", h.name, h.label, lv, s.sum)
+		fmt.Fprintf(b, "%s_count{%s=%q} %d\n", h.name, h.label, lv, s.count)
+	}
+}
+
+func formatBound(b float64) string {
+	return fmt.Sprintf("%g", b)
+}
+
+func stateKeys(m map[string]*histogramState) map[string]float64 {
+	keys := make(map[string]float64, len(m))
+	for k := range m {
+		keys[k] = 0
+	}
+	return keys
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCounterVecRendersPromExposition(t *testing.T) {
+	r := NewRegistry()
+	c := NewCounterVec(r, "punch_test_total", "A test counter.", "kind")
+	c.Inc("a")
+	c.Add("a", 2)
+	c.Inc("b")
+
+	var b strings.Builder
+	c.writeProm(&b)
+	out := b.String()
+
+	if !strings.Contains(out, `punch_test_total{kind="a"} 3`) {
+		t.Fatalf("want accumulated count for a, got:\n%s", out)
+	}
+	if !strings.Contains(out, `punch_test_total{kind="b"} 1`) {
+		t.Fatalf("want count for b, got:\n%s", out)
+	}
+}
+
+func TestHistogramVecBucketsAreCumulative(t *testing.T) {
+	h := NewHistogramVec(NewRegistry(), "punch_test_duration_seconds", "A test histogram.", "analyzer", []float64{0.1, 1})
+	h.Observe("x", 0.05)
+	h.Observe("x", 0.5)
+
+	var b strings.Builder
+	h.writeProm(&b)
+	out := b.String()
+
+	if !strings.Contains(out, `le="0.1"} 1`) {
+		t.Fatalf("want 1 observation in the 0.1 bucket, got:\n%s", out)
+	}
+	if !strings.Contains(out, `le="1"} 2`) {
+		t.Fatalf("want both observations in the 1 bucket, got:\n%s", out)
+	}
+	if !strings.Contains(out, `_count{analyzer="x"} 2`) {
+		t.Fatalf("want total count of 2, got:\n%s", out)
+	}
+}
@@ -0,0 +1,16 @@
+package rulelist
+
+import "fmt"
+
+// ErrBadRule is returned for a single malformed rule line. Parse keeps
+// going after one, so a rules file with several typos reports all of
+// them in one pass instead of stopping at the first.
+type ErrBadRule struct {
+	Line   int
+	Column int
+	Reason string
+}
+
+func (e *ErrBadRule) Error() string {
+	return fmt.Sprintf("rulelist: line %d, column %d: %s", e.Line, e.Column, e.Reason)
+}
@@ -0,0 +1,86 @@
+package rulelist
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+// Match is one rule firing against a specific location.
+type Match struct {
+	Rule Rule
+	// Fn is the enclosing function the rule matched within.
+	Fn   *rustast.Fn
+	Span rustast.Span
+}
+
+// Evaluate runs every rule in rules against file, returning one Match per
+// location where a rule's Matches clause (and Contains clause, if any)
+// is satisfied. Only the `Fn` matcher kind, with a `Call` contains
+// clause, is supported today; that is the shape the async analyzer
+// needs, and it is the example the rules grammar itself documents.
+func Evaluate(file *rustast.File, rules []Rule) []Match {
+	var matches []Match
+
+	for _, item := range file.Items {
+		fn, ok := item.(*rustast.Fn)
+		if !ok {
+			continue
+		}
+
+		for _, rule := range rules {
+			if rule.Matches.Kind != "Fn" || !fnMatches(fn, rule.Matches) {
+				continue
+			}
+
+			if rule.Contains == nil {
+				matches = append(matches, Match{Rule: rule, Fn: fn, Span: fn.Span})
+				continue
+			}
+			if rule.Contains.Kind != "Call" {
+				continue
+			}
+			for _, call := range fn.Calls {
+				if callMatches(call, *rule.Contains) {
+					matches = append(matches, Match{Rule: rule, Fn: fn, Span: call.Span})
+				}
+			}
+		}
+	}
+
+	return matches
+}
+
+func fnMatches(fn *rustast.Fn, m Matcher) bool {
+	if v, ok := m.Attrs["async"]; ok {
+		want, err := strconv.ParseBool(v)
+		if err != nil {
+			return false
+		}
+		if fn.IsAsync != want {
+			return false
+		}
+	}
+	return true
+}
+
+func callMatches(call rustast.MethodCall, m Matcher) bool {
+	path, ok := m.Attrs["path"]
+	if !ok {
+		return true
+	}
+	// path is written as a fully-qualified Rust path (e.g.
+	// "std::thread::sleep"); the lightweight rustast scanner only
+	// records the receiver token immediately before the call, so match
+	// on the call's trailing segment(s) instead of the whole path.
+	segments := strings.Split(path, "::")
+	last := segments[len(segments)-1]
+	if call.Method != last {
+		return false
+	}
+	if len(segments) >= 2 {
+		return call.Receiver == segments[len(segments)-2]
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package rulelist
+
+import (
+	"testing"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+func TestEvaluateMatchesRealBlockingSleepCall(t *testing.T) {
+	src := `async fn handler() { std::thread::sleep(Duration::from_secs(1)); }`
+	file := rustast.Parse(src)
+
+	rules, errs := Parse(`id "PUNCH-ASYNC-001" severity=warn matches ast:Fn[async=true] contains ast:Call[path="std::thread::sleep"] message "blocking sleep in async fn"`)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected parse errors: %v", errs)
+	}
+
+	matches := Evaluate(file, rules)
+	if len(matches) != 1 {
+		t.Fatalf("want 1 match against a real std::thread::sleep call, got %d", len(matches))
+	}
+	if matches[0].Fn.Name != "handler" {
+		t.Fatalf("want match attributed to handler, got %q", matches[0].Fn.Name)
+	}
+}
+
+func TestEvaluateDoesNotMatchNonBlockingAsyncFn(t *testing.T) {
+	src := `async fn handler() { tokio::time::sleep(Duration::from_secs(1)).await; }`
+	file := rustast.Parse(src)
+
+	rules, _ := Parse(`id "PUNCH-ASYNC-001" severity=warn matches ast:Fn[async=true] contains ast:Call[path="std::thread::sleep"] message "blocking sleep in async fn"`)
+
+	matches := Evaluate(file, rules)
+	if len(matches) != 0 {
+		t.Fatalf("want no match for tokio::time::sleep, got %d", len(matches))
+	}
+}
@@ -0,0 +1,100 @@
+package rulelist
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	ruleLinePattern = regexp.MustCompile(
+		`^id\s+"([^"]+)"\s+severity=(\w+)\s+matches\s+(ast:\w+\[[^\]]*\])\s+` +
+			`(?:contains\s+(ast:\w+\[[^\]]*\])\s+)?message\s+"([^"]*)"\s*$`)
+	matcherPattern = regexp.MustCompile(`^ast:(\w+)\[([^\]]*)\]$`)
+)
+
+// Parse parses every rule in text, one per line. It does not stop at the
+// first malformed line: each bad line produces an *ErrBadRule and
+// parsing continues, so a rules file with several mistakes reports all
+// of them together.
+func Parse(text string) ([]Rule, []*ErrBadRule) {
+	var rules []Rule
+	var errs []*ErrBadRule
+
+	for i, line := range strings.Split(text, "\n") {
+		lineNum := i + 1
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		rule, err := parseLine(trimmed, lineNum)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rules = append(rules, *rule)
+	}
+
+	return rules, errs
+}
+
+func parseLine(line string, lineNum int) (*Rule, *ErrBadRule) {
+	m := ruleLinePattern.FindStringSubmatch(line)
+	if m == nil {
+		return nil, &ErrBadRule{Line: lineNum, Column: 1, Reason: "does not match `id \"...\" severity=... matches ast:Kind[...] [contains ast:Kind[...]] message \"...\"`"}
+	}
+
+	id, severityText, matchesText, containsText, message := m[1], m[2], m[3], m[4], m[5]
+
+	severity := Severity(severityText)
+	switch severity {
+	case SeverityInfo, SeverityWarn, SeverityError:
+	default:
+		col := strings.Index(line, "severity=") + 1
+		return nil, &ErrBadRule{Line: lineNum, Column: col, Reason: "severity must be one of info, warn, error, got " + severityText}
+	}
+
+	matches, err := parseMatcher(matchesText, lineNum, strings.Index(line, matchesText)+1)
+	if err != nil {
+		return nil, err
+	}
+
+	var contains *Matcher
+	if containsText != "" {
+		c, err := parseMatcher(containsText, lineNum, strings.Index(line, containsText)+1)
+		if err != nil {
+			return nil, err
+		}
+		contains = c
+	}
+
+	return &Rule{
+		ID:       id,
+		Severity: severity,
+		Matches:  *matches,
+		Contains: contains,
+		Message:  message,
+		Span:     Span{Line: lineNum, Column: 1},
+	}, nil
+}
+
+func parseMatcher(text string, lineNum, col int) (*Matcher, *ErrBadRule) {
+	m := matcherPattern.FindStringSubmatch(text)
+	if m == nil {
+		return nil, &ErrBadRule{Line: lineNum, Column: col, Reason: "malformed matcher " + text}
+	}
+	kind, attrsText := m[1], m[2]
+
+	attrs := map[string]string{}
+	if strings.TrimSpace(attrsText) != "" {
+		for _, part := range strings.Split(attrsText, ",") {
+			kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+			if len(kv) != 2 {
+				return nil, &ErrBadRule{Line: lineNum, Column: col, Reason: "malformed attribute " + part}
+			}
+			attrs[strings.TrimSpace(kv[0])] = strings.Trim(strings.TrimSpace(kv[1]), `"`)
+		}
+	}
+
+	return &Matcher{Kind: kind, Attrs: attrs}, nil
+}
@@ -0,0 +1,57 @@
+package rulelist
+
+import "testing"
+
+const sampleRule = `id "PUNCH-ASYNC-001" severity=warn matches ast:Fn[async=true] contains ast:Call[path="std::thread::sleep"] message "blocking sleep in async fn"`
+
+func TestParseValidRule(t *testing.T) {
+	rules, errs := Parse(sampleRule)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(rules) != 1 {
+		t.Fatalf("want 1 rule, got %d", len(rules))
+	}
+	r := rules[0]
+	if r.ID != "PUNCH-ASYNC-001" || r.Severity != SeverityWarn {
+		t.Fatalf("unexpected rule: %+v", r)
+	}
+	if r.Matches.Kind != "Fn" || r.Matches.Attrs["async"] != "true" {
+		t.Fatalf("unexpected matches clause: %+v", r.Matches)
+	}
+	if r.Contains == nil || r.Contains.Kind != "Call" || r.Contains.Attrs["path"] != "std::thread::sleep" {
+		t.Fatalf("unexpected contains clause: %+v", r.Contains)
+	}
+}
+
+func TestParseBadRuleReportsLineAndColumn(t *testing.T) {
+	text := sampleRule + "\nthis is not a rule\n" + sampleRule
+	rules, errs := Parse(text)
+	if len(rules) != 2 {
+		t.Fatalf("want 2 valid rules, got %d", len(rules))
+	}
+	if len(errs) != 1 {
+		t.Fatalf("want 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Line != 2 {
+		t.Fatalf("want error on line 2, got %d", errs[0].Line)
+	}
+}
+
+func FuzzParseRuleList(f *testing.F) {
+	f.Add(sampleRule)
+	f.Add("")
+	f.Add("# just a comment\n" + sampleRule)
+	f.Add(`id "X" severity=bogus matches ast:Fn[] message "m"`)
+	f.Fuzz(func(t *testing.T, s string) {
+		// Parse must never panic, regardless of input.
+		Parse(s)
+	})
+}
+
+func BenchmarkParseRuleList(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Parse(sampleRule)
+	}
+}
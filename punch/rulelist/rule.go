@@ -0,0 +1,45 @@
+// Package rulelist parses a user-authored rules file describing Rust
+// anti-patterns for PUNCH's analyzers to flag, so detection can be
+// extended without recompiling.
+//
+// Grammar, one rule per line:
+//
+//	id "PUNCH-ASYNC-001" severity=warn matches ast:Fn[async=true] contains ast:Call[path="std::thread::sleep"] message "blocking sleep in async fn"
+//
+// The `contains` clause is optional. Blank lines and lines starting with
+// `#` are ignored.
+package rulelist
+
+// Severity is a rule's reported severity.
+type Severity string
+
+const (
+	SeverityInfo  Severity = "info"
+	SeverityWarn  Severity = "warn"
+	SeverityError Severity = "error"
+)
+
+// Span is a 1-indexed source location within the rules file.
+type Span struct {
+	Line   int
+	Column int
+}
+
+// Matcher is one `ast:Kind[attr=value,...]` clause.
+type Matcher struct {
+	Kind  string
+	Attrs map[string]string
+}
+
+// Rule is one fully parsed rule.
+type Rule struct {
+	ID       string
+	Severity Severity
+	Matches  Matcher
+	// Contains is the nested clause a Matches hit must contain to fire,
+	// e.g. a blocking call inside an async fn. Nil when the rule has no
+	// `contains` clause.
+	Contains *Matcher
+	Message  string
+	Span     Span
+}
@@ -0,0 +1,200 @@
+package rustast
+
+import "strings"
+
+type tokenKind int
+
+const (
+	tokIdent tokenKind = iota
+	tokPunct
+	tokString
+	tokOther
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+	col  int
+}
+
+// tokenize performs a minimal Rust lexical scan: it correctly skips line
+// and block comments and string/char literals (so punctuation inside them
+// is never mistaken for real syntax) and otherwise splits the source into
+// identifiers and single-character punctuation tokens.
+func tokenize(src string) []token {
+	var toks []token
+	line, col := 1, 1
+	i := 0
+	n := len(src)
+
+	// Track line/col incrementally as we consume runes.
+	step := func() byte {
+		b := src[i]
+		if b == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+		i++
+		return b
+	}
+
+	for i < n {
+		c := src[i]
+
+		// A plain byte string/char (b"..."/b'x'') is just its non-byte
+		// form with a 'b' prefix; strip the prefix and let the existing
+		// quote-handling cases below tokenize the rest.
+		if c == 'b' && i+1 < n && (src[i+1] == '"' || src[i+1] == '\'') {
+			step()
+			c = src[i]
+		}
+
+		// Raw strings (r"...", r#"..."#, ...) and raw byte strings
+		// (br"...", br#"..."#, ...) don't process escapes, so a
+		// backslash inside one must not be treated as an escape by the
+		// '"' case below; handle the whole literal here instead.
+		if c == 'r' || c == 'b' {
+			if length, ok := scanRawString(src, i); ok {
+				startLine, startCol := line, col
+				start := i
+				for k := 0; k < length; k++ {
+					step()
+				}
+				toks = append(toks, token{kind: tokString, text: src[start:i], line: startLine, col: startCol})
+				continue
+			}
+		}
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			step()
+
+		case c == '/' && i+1 < n && src[i+1] == '/':
+			for i < n && src[i] != '\n' {
+				step()
+			}
+
+		case c == '/' && i+1 < n && src[i+1] == '*':
+			startLine, startCol := line, col
+			step()
+			step()
+			depth := 1
+			for i < n && depth > 0 {
+				if i+1 < n && src[i] == '/' && src[i+1] == '*' {
+					step()
+					step()
+					depth++
+					continue
+				}
+				if i+1 < n && src[i] == '*' && src[i+1] == '/' {
+					step()
+					step()
+					depth--
+					continue
+				}
+				step()
+			}
+			_ = startLine
+			_ = startCol
+
+		case c == '"':
+			startLine, startCol := line, col
+			start := i
+			step()
+			for i < n && src[i] != '"' {
+				if src[i] == '\\' && i+1 < n {
+					step()
+				}
+				step()
+			}
+			if i < n {
+				step()
+			}
+			toks = append(toks, token{kind: tokString, text: src[start:i], line: startLine, col: startCol})
+
+		case c == '\'':
+			// Rust char literal or lifetime ('a). Treat conservatively:
+			// scan up to the next quote only if it looks like a char
+			// literal (closes within a couple of characters); otherwise
+			// leave the quote as a single punct token for a lifetime.
+			startLine, startCol := line, col
+			start := i
+			if i+2 < n && src[i+2] == '\'' {
+				step()
+				step()
+				step()
+				toks = append(toks, token{kind: tokString, text: src[start:i], line: startLine, col: startCol})
+			} else if i+1 < n && src[i+1] == '\\' {
+				j := i + 2
+				for j < n && src[j] != '\'' {
+					j++
+				}
+				for i <= j && i < n {
+					step()
+				}
+				toks = append(toks, token{kind: tokString, text: src[start:i], line: startLine, col: startCol})
+			} else {
+				step()
+				toks = append(toks, token{kind: tokPunct, text: "'", line: startLine, col: startCol})
+			}
+
+		case isIdentStart(c):
+			startLine, startCol := line, col
+			start := i
+			for i < n && isIdentPart(src[i]) {
+				step()
+			}
+			toks = append(toks, token{kind: tokIdent, text: src[start:i], line: startLine, col: startCol})
+
+		default:
+			startLine, startCol := line, col
+			step()
+			toks = append(toks, token{kind: tokPunct, text: string(c), line: startLine, col: startCol})
+		}
+	}
+
+	return toks
+}
+
+// scanRawString reports whether src[i:] is a raw string or raw byte
+// string literal (an optional 'b', then 'r', then zero or more '#', then
+// '"'), and if so returns its total length including the opening and
+// closing delimiters. It does not consume any input itself.
+func scanRawString(src string, i int) (int, bool) {
+	n := len(src)
+	j := i
+	if j < n && src[j] == 'b' {
+		j++
+	}
+	if j >= n || src[j] != 'r' {
+		return 0, false
+	}
+	j++
+	hashes := 0
+	for j < n && src[j] == '#' {
+		hashes++
+		j++
+	}
+	if j >= n || src[j] != '"' {
+		return 0, false
+	}
+	j++
+
+	closer := "\"" + strings.Repeat("#", hashes)
+	idx := strings.Index(src[j:], closer)
+	if idx < 0 {
+		return n - i, true
+	}
+	return j + idx + len(closer) - i, true
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,170 @@
+package rustast
+
+import "strings"
+
+// PathUsage is a single `a::b::c`-shaped path reference found anywhere in
+// a source file (a `use` import, a fully-qualified call, a type
+// reference, ...).
+type PathUsage struct {
+	Path string
+	Span Span
+}
+
+// FindPathUsages scans src for `ident (:: ident)+` token sequences and
+// returns each as a dotted path, e.g. "tokio::net::TcpStream". It also
+// follows into brace-grouped imports (`use axum::{Router, routing::get}`),
+// the standard rustfmt style for multi-item imports from one path, so
+// each item is reported with the group's path prepended, e.g.
+// "axum::Router" and "axum::routing::get". It is used to approximate
+// call-site reachability: a vulnerable module is only worth flagging if
+// the crate actually references it.
+func FindPathUsages(src string) []PathUsage {
+	toks := tokenize(src)
+	return scanPathUsages(toks, nil)
+}
+
+// scanPathUsages walks toks looking for `ident (:: ident)+` sequences,
+// prepending prefix to every path it records. A sequence immediately
+// followed by a `{ ... }` group is treated as the root of a grouped
+// import: each comma-separated entry inside the braces is scanned
+// recursively with the sequence's own segments as its prefix.
+func scanPathUsages(toks []token, prefix []string) []PathUsage {
+	var usages []PathUsage
+
+	for i := 0; i < len(toks); i++ {
+		if toks[i].kind != tokIdent {
+			continue
+		}
+
+		start := i
+		segs, next := scanPathSegments(toks, i)
+
+		if next < len(toks) && toks[next].text == "{" {
+			end := matchingBrace(toks, next)
+			if end < 0 {
+				i = len(toks)
+				break
+			}
+			usages = append(usages, expandGroup(toks[next+1:end], append(append([]string{}, prefix...), segs...))...)
+			i = end
+			continue
+		}
+
+		if len(segs) > 1 {
+			usages = append(usages, PathUsage{
+				Path: strings.Join(append(append([]string{}, prefix...), segs...), "::"),
+				Span: Span{toks[start].line, toks[start].col},
+			})
+		}
+		i = next - 1
+	}
+
+	return usages
+}
+
+// scanPathSegments consumes an `ident (:: ident)*` sequence starting at
+// toks[i] and returns its segments plus the index of the first token not
+// part of the sequence (which may be a `{` opening a grouped import).
+func scanPathSegments(toks []token, i int) ([]string, int) {
+	segs := []string{toks[i].text}
+	j := i + 1
+	for j+1 < len(toks) && toks[j].text == ":" && toks[j+1].text == ":" {
+		j += 2
+		if j < len(toks) && toks[j].kind == tokIdent {
+			segs = append(segs, toks[j].text)
+			j++
+		} else {
+			break
+		}
+	}
+	return segs, j
+}
+
+// expandGroup splits the contents of a `{ ... }` import group (braces
+// already stripped) into its top-level comma-separated entries and
+// reports a PathUsage for each, prefixed by the group's own path. An
+// entry of bare `self` refers to the prefix itself. An entry that is
+// itself a nested group (`routing::{get, post}`) is expanded recursively.
+func expandGroup(inner []token, prefix []string) []PathUsage {
+	var usages []PathUsage
+	for _, entry := range splitTopLevel(inner) {
+		if len(entry) == 0 {
+			continue
+		}
+		if len(entry) == 1 && entry[0].text == "self" {
+			usages = append(usages, PathUsage{
+				Path: strings.Join(prefix, "::"),
+				Span: Span{entry[0].line, entry[0].col},
+			})
+			continue
+		}
+		usages = append(usages, scanPathUsagesAsGroupEntry(entry, prefix)...)
+	}
+	return usages
+}
+
+// scanPathUsagesAsGroupEntry resolves one grouped-import entry (a plain
+// path, or a path followed by its own nested `{ ... }` group) against
+// prefix and returns it as a single PathUsage (or, for a nested group,
+// one per nested entry).
+func scanPathUsagesAsGroupEntry(entry []token, prefix []string) []PathUsage {
+	segs, next := scanPathSegments(entry, 0)
+	full := append(append([]string{}, prefix...), segs...)
+
+	if next < len(entry) && entry[next].text == "{" {
+		end := matchingBrace(entry, next)
+		if end < 0 {
+			return nil
+		}
+		return expandGroup(entry[next+1:end], full)
+	}
+
+	return []PathUsage{{
+		Path: strings.Join(full, "::"),
+		Span: Span{entry[0].line, entry[0].col},
+	}}
+}
+
+// matchingBrace returns the index of the "}" matching the "{" at open,
+// accounting for nested braces, or -1 if it never closes.
+func matchingBrace(toks []token, open int) int {
+	depth := 0
+	for i := open; i < len(toks); i++ {
+		switch toks[i].text {
+		case "{":
+			depth++
+		case "}":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// splitTopLevel splits toks on "," tokens that are not nested inside a
+// "{ ... }" group, dropping a trailing empty entry left by a trailing
+// comma.
+func splitTopLevel(toks []token) [][]token {
+	var entries [][]token
+	depth := 0
+	start := 0
+	for i, t := range toks {
+		switch t.text {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		case ",":
+			if depth == 0 {
+				entries = append(entries, toks[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if start < len(toks) {
+		entries = append(entries, toks[start:])
+	}
+	return entries
+}
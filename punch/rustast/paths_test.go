@@ -0,0 +1,52 @@
+package rustast
+
+import "testing"
+
+func TestFindPathUsagesFlatPath(t *testing.T) {
+	usages := FindPathUsages(`use tokio::net::TcpStream;`)
+	if !hasPath(usages, "tokio::net::TcpStream") {
+		t.Fatalf("want tokio::net::TcpStream, got %+v", usages)
+	}
+}
+
+func TestFindPathUsagesFollowsGroupedImport(t *testing.T) {
+	usages := FindPathUsages(`use axum::{Router, routing::get};`)
+	if !hasPath(usages, "axum::Router") {
+		t.Fatalf("want axum::Router, got %+v", usages)
+	}
+	if !hasPath(usages, "axum::routing::get") {
+		t.Fatalf("want axum::routing::get, got %+v", usages)
+	}
+}
+
+func TestFindPathUsagesGroupedImportWithSelf(t *testing.T) {
+	usages := FindPathUsages(`use tokio::net::{self, TcpStream};`)
+	if !hasPath(usages, "tokio::net") {
+		t.Fatalf("want tokio::net from the self entry, got %+v", usages)
+	}
+	if !hasPath(usages, "tokio::net::TcpStream") {
+		t.Fatalf("want tokio::net::TcpStream, got %+v", usages)
+	}
+}
+
+func TestFindPathUsagesNestedGroup(t *testing.T) {
+	usages := FindPathUsages(`use tokio::{net::TcpStream, time::{sleep, Duration}};`)
+	if !hasPath(usages, "tokio::net::TcpStream") {
+		t.Fatalf("want tokio::net::TcpStream, got %+v", usages)
+	}
+	if !hasPath(usages, "tokio::time::sleep") {
+		t.Fatalf("want tokio::time::sleep, got %+v", usages)
+	}
+	if !hasPath(usages, "tokio::time::Duration") {
+		t.Fatalf("want tokio::time::Duration, got %+v", usages)
+	}
+}
+
+func hasPath(usages []PathUsage, path string) bool {
+	for _, u := range usages {
+		if u.Path == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,338 @@
+// Package rustast provides a lightweight structural scanner over Rust
+// source text. It is not a full Rust grammar (there is no dependency on
+// syn, rust-analyzer, or tree-sitter-rust available to this tree) but it
+// does tokenize comments and string/char literals correctly before
+// looking for items, so it does not get confused by "fn" or ".await"
+// appearing inside a string or a comment the way plain substring/line
+// matching does. It exists so the higher-level analyzers can walk a real
+// typed structure (File, Fn, Attr, ...) instead of grepping lines.
+package rustast
+
+import "strings"
+
+// Span is a 1-indexed source location.
+type Span struct {
+	Line int
+	Col  int
+}
+
+// Attr is an attribute macro such as `#[tokio::main]` or `#[get("/users")]`.
+type Attr struct {
+	// Path is the attribute's leading path, e.g. "tokio::main" or "get".
+	Path string
+	// Tokens is the raw text inside the attribute's parentheses, if any
+	// (e.g. `"/users"` for `#[get("/users")]`), empty otherwise.
+	Tokens string
+	Span   Span
+}
+
+// MethodCall is a `.method(args)` call found anywhere in a function body,
+// e.g. `.await`, `.route("/x", get(handler))`, `.layer(...)`.
+type MethodCall struct {
+	// Receiver is the best-effort source text immediately preceding the
+	// `.`, e.g. the identifier or `)`/`]` that closes the receiver
+	// expression. It is not a resolved type.
+	Receiver string
+	Method   string
+	Args     string
+	Span     Span
+}
+
+// Fn is a function item.
+type Fn struct {
+	Name    string
+	IsAsync bool
+	Attrs   []Attr
+	// Awaits holds the span of every `.await` postfix expression in the
+	// function body.
+	Awaits []Span
+	// Calls holds every other `.method(args)` call in the function body.
+	Calls []MethodCall
+	Span  Span
+}
+
+// Item is any top-level construct File.Items can hold. Only Fn is
+// populated today; it is an interface so future item kinds (structs,
+// impls, ...) can be added without breaking callers that type-switch.
+type Item interface {
+	itemNode()
+}
+
+func (*Fn) itemNode() {}
+
+// File is the root of a parsed source file.
+type File struct {
+	Items []Item
+}
+
+// Parse scans src and returns its top-level Fn items.
+func Parse(src string) *File {
+	toks := tokenize(src)
+	f := &File{}
+
+	var pending []Attr
+	for i := 0; i < len(toks); i++ {
+		t := toks[i]
+
+		if t.kind == tokPunct && t.text == "#" && i+1 < len(toks) && toks[i+1].text == "[" {
+			attr, next := parseAttr(toks, i)
+			pending = append(pending, attr)
+			i = next
+			continue
+		}
+
+		if t.kind == tokIdent && t.text == "fn" {
+			fn, next := parseFn(toks, i)
+			fn.Attrs = pending
+			pending = nil
+			f.Items = append(f.Items, fn)
+			i = next
+			continue
+		}
+
+		if t.kind == tokIdent && !isModifierKeyword(t.text) {
+			// Any other top-level identifier resets pending attrs: they
+			// belonged to whatever item this is, which we don't model.
+			pending = nil
+		}
+	}
+
+	return f
+}
+
+func isModifierKeyword(s string) bool {
+	switch s {
+	case "pub", "async", "unsafe", "extern", "const":
+		return true
+	}
+	return false
+}
+
+// parseAttr consumes a `#[ ... ]` group starting at toks[i] == "#" and
+// returns the parsed Attr plus the index of the closing `]`.
+func parseAttr(toks []token, i int) (Attr, int) {
+	start := toks[i]
+	j := i + 2 // skip '#' '['
+	var pathParts []string
+	for j < len(toks) && toks[j].text != "(" && toks[j].text != "]" {
+		pathParts = append(pathParts, toks[j].text)
+		j++
+	}
+	attr := Attr{Path: strings.Join(pathParts, ""), Span: Span{start.line, start.col}}
+
+	if j < len(toks) && toks[j].text == "(" {
+		depth := 0
+		argStart := j
+		for ; j < len(toks); j++ {
+			if toks[j].text == "(" {
+				depth++
+			} else if toks[j].text == ")" {
+				depth--
+				if depth == 0 {
+					break
+				}
+			}
+		}
+		attr.Tokens = joinRaw(toks[argStart+1 : j])
+	}
+
+	for j < len(toks) && toks[j].text != "]" {
+		j++
+	}
+	return attr, j
+}
+
+// parseFn consumes a function item starting at toks[i] == "fn" and
+// returns it plus the index of the closing brace of its body (or of the
+// trailing `;` for a body-less `fn foo();` in a trait/extern block).
+func parseFn(toks []token, i int) (*Fn, int) {
+	start := toks[i]
+	fn := &Fn{Span: Span{start.line, start.col}}
+
+	// Was this fn preceded immediately (ignoring attrs already consumed)
+	// by `async`? Walk back past modifier keywords.
+	for k := i - 1; k >= 0; k-- {
+		switch toks[k].text {
+		case "async":
+			fn.IsAsync = true
+			continue
+		case "pub", "unsafe", "extern", "const":
+			continue
+		}
+		break
+	}
+
+	j := i + 1
+	if j < len(toks) && toks[j].kind == tokIdent {
+		fn.Name = toks[j].text
+		j++
+	}
+
+	// Skip to the body's opening brace or a terminating semicolon.
+	depthParen := 0
+	for ; j < len(toks); j++ {
+		switch toks[j].text {
+		case "(":
+			depthParen++
+		case ")":
+			depthParen--
+		case "{":
+			if depthParen == 0 {
+				goto body
+			}
+		case ";":
+			if depthParen == 0 {
+				return fn, j
+			}
+		}
+	}
+	return fn, len(toks) - 1
+
+body:
+	bodyStart := j
+	depth := 0
+	for ; j < len(toks); j++ {
+		switch toks[j].text {
+		case "{":
+			depth++
+		case "}":
+			depth--
+			if depth == 0 {
+				scanFnBody(toks[bodyStart+1:j], fn)
+				return fn, j
+			}
+		}
+	}
+	return fn, len(toks) - 1
+}
+
+// scanFnBody records every `.await`, `.method(args)` call, and qualified
+// free-function call (e.g. `std::thread::sleep(...)`) found in a function
+// body's token range.
+func scanFnBody(toks []token, fn *Fn) {
+	for i := 0; i < len(toks); i++ {
+		if toks[i].kind == tokIdent && isPathCallStart(toks, i) {
+			if call, next, ok := scanPathCall(toks, i); ok {
+				fn.Calls = append(fn.Calls, call)
+				i = next
+				continue
+			}
+		}
+
+		if toks[i].text != "." {
+			continue
+		}
+		if i+1 >= len(toks) {
+			continue
+		}
+		next := toks[i+1]
+
+		if next.text == "await" {
+			fn.Awaits = append(fn.Awaits, Span{next.line, next.col})
+			continue
+		}
+
+		if next.kind != tokIdent || i+2 >= len(toks) || toks[i+2].text != "(" {
+			continue
+		}
+		depth := 0
+		argStart := i + 2
+		j := argStart
+		for ; j < len(toks); j++ {
+			if toks[j].text == "(" {
+				depth++
+			} else if toks[j].text == ")" {
+				depth--
+				if depth == 0 {
+					break
+				}
+			}
+		}
+		receiver := ""
+		if i > 0 {
+			receiver = toks[i-1].text
+		}
+		fn.Calls = append(fn.Calls, MethodCall{
+			Receiver: receiver,
+			Method:   next.text,
+			Args:     joinRaw(toks[argStart+1 : minInt(j, len(toks))]),
+			Span:     Span{next.line, next.col},
+		})
+	}
+}
+
+// isPathCallStart reports whether toks[i] can begin a fresh `a::b::c(...)`
+// path, i.e. it isn't itself the continuation of a `::` path or the
+// segment after a `.` (a method access, which scanFnBody's "." handling
+// above already covers).
+func isPathCallStart(toks []token, i int) bool {
+	if i == 0 {
+		return true
+	}
+	switch toks[i-1].text {
+	case ":", ".":
+		return false
+	}
+	return true
+}
+
+// scanPathCall consumes a `ident (:: ident)+ (args)` sequence starting at
+// toks[i] and, if it is immediately followed by a call's parentheses,
+// returns it as a MethodCall (Receiver holding the second-to-last path
+// segment and Method the last, the same shape a `.method()` postfix call
+// produces) plus the index of its closing paren. ok is false for a bare
+// path with no trailing call, or a single unqualified identifier.
+func scanPathCall(toks []token, i int) (MethodCall, int, bool) {
+	segments := []string{toks[i].text}
+	j := i + 1
+	for j+1 < len(toks) && toks[j].text == ":" && toks[j+1].text == ":" {
+		j += 2
+		if j >= len(toks) || toks[j].kind != tokIdent {
+			return MethodCall{}, 0, false
+		}
+		segments = append(segments, toks[j].text)
+		j++
+	}
+	if len(segments) < 2 || j >= len(toks) || toks[j].text != "(" {
+		return MethodCall{}, 0, false
+	}
+
+	depth := 0
+	argStart := j
+	k := j
+	for ; k < len(toks); k++ {
+		if toks[k].text == "(" {
+			depth++
+		} else if toks[k].text == ")" {
+			depth--
+			if depth == 0 {
+				break
+			}
+		}
+	}
+
+	return MethodCall{
+		Receiver: segments[len(segments)-2],
+		Method:   segments[len(segments)-1],
+		Args:     joinRaw(toks[argStart+1 : minInt(k, len(toks))]),
+		Span:     Span{toks[i].line, toks[i].col},
+	}, k, true
+}
+
+func joinRaw(toks []token) string {
+	var b strings.Builder
+	for i, t := range toks {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t.text)
+	}
+	return b.String()
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
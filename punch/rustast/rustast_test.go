@@ -0,0 +1,77 @@
+package rustast
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRecordsQualifiedFreeFunctionCalls(t *testing.T) {
+	src := `async fn handler() { std::thread::sleep(Duration::from_secs(1)); }`
+	file := Parse(src)
+	if len(file.Items) != 1 {
+		t.Fatalf("want 1 item, got %d", len(file.Items))
+	}
+	fn := file.Items[0].(*Fn)
+	if len(fn.Calls) == 0 {
+		t.Fatalf("want at least one recorded call, got none")
+	}
+	var found bool
+	for _, c := range fn.Calls {
+		if c.Receiver == "thread" && c.Method == "sleep" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("want a Receiver=thread Method=sleep call, got %+v", fn.Calls)
+	}
+}
+
+func TestParseHandlesRawAndByteStrings(t *testing.T) {
+	src := `fn query() {
+    let q = r#"SELECT * FROM users WHERE name = "o'brien""#;
+    let b = b"bytes";
+    let rb = br#"raw bytes "quoted""#;
+    let after = 1;
+}`
+	file := Parse(src)
+	if len(file.Items) != 1 {
+		t.Fatalf("raw/byte strings desynced tokenization: want 1 fn, got %d", len(file.Items))
+	}
+}
+
+func TestParseAttrTokensPreserveStringLiteralContent(t *testing.T) {
+	src := "#[get(\"/users/{id}\")]\nasync fn get_user() {}"
+	file := Parse(src)
+	if len(file.Items) != 1 {
+		t.Fatalf("want 1 item, got %d", len(file.Items))
+	}
+	fn := file.Items[0].(*Fn)
+	if len(fn.Attrs) != 1 {
+		t.Fatalf("want 1 attr, got %d", len(fn.Attrs))
+	}
+	attr := fn.Attrs[0]
+	if attr.Path != "get" {
+		t.Fatalf("want attr path %q, got %q", "get", attr.Path)
+	}
+	if attr.Tokens != `"/users/{id}"` {
+		t.Fatalf("want attr tokens to preserve the literal route path, got %q", attr.Tokens)
+	}
+}
+
+func TestParseMethodCallArgsPreserveStringLiteralContent(t *testing.T) {
+	src := `fn routes() { Router::new().route("/users/:id", get(list_users)); }`
+	file := Parse(src)
+	fn := file.Items[0].(*Fn)
+	var found bool
+	for _, c := range fn.Calls {
+		if c.Method == "route" {
+			found = true
+			if !strings.Contains(c.Args, `"/users/:id"`) {
+				t.Fatalf("want route call args to preserve the literal path, got %q", c.Args)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("want a route call, got %+v", fn.Calls)
+	}
+}
@@ -0,0 +1,220 @@
+// Package vulns reports RustSec advisories affecting a crate's locked
+// dependencies, and narrows each advisory down to whether the crate's own
+// source actually reaches the vulnerable module (rather than merely
+// depending on the vulnerable crate transitively and never calling into
+// the affected path).
+//
+// It does not fetch the advisory database itself: the RustSec
+// advisory-db git mirror, or the output of `cargo audit --json`, is
+// expected to already be available on disk (fetching it is the CLI
+// layer's job, and fetching over the network from inside a library
+// makes results nondeterministic and untestable).
+package vulns
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/Michael-A-Kuykendall/rustchain-community/punch/rustast"
+)
+
+// Advisory is one RustSec advisory entry, modeled after the `cargo audit
+// --json` / advisory-db TOML schema's fields that matter for reporting.
+type Advisory struct {
+	ID              string   `json:"id"`
+	Package         string   `json:"package"`
+	Severity        string   `json:"severity"`
+	CVSS            float64  `json:"cvss"`
+	PatchedVersions []string `json:"patched_versions"`
+	// AffectedPaths are the module paths (e.g. "tokio::net::TcpStream")
+	// that must actually appear in source for the advisory to be
+	// reachable, as opposed to merely a transitive dependency.
+	AffectedPaths []string `json:"affected_paths"`
+}
+
+// AdvisoryDB is a loaded collection of advisories, indexed by package.
+type AdvisoryDB struct {
+	Advisories []Advisory
+}
+
+// LoadAdvisoryDB reads a JSON advisory dump (the shape `cargo audit
+// --json` produces, trimmed to the fields above) from path.
+func LoadAdvisoryDB(path string) (*AdvisoryDB, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var advisories []Advisory
+	if err := json.Unmarshal(data, &advisories); err != nil {
+		return nil, err
+	}
+	return &AdvisoryDB{Advisories: advisories}, nil
+}
+
+// LockedCrate is one `[[package]]` entry from a Cargo.lock.
+type LockedCrate struct {
+	Name    string
+	Version string
+}
+
+var (
+	lockPackageHeaderPattern = regexp.MustCompile(`(?m)^\[\[package\]\]\s*$`)
+	lockNamePattern          = regexp.MustCompile(`(?m)^name\s*=\s*"([^"]+)"`)
+	lockVersionPattern       = regexp.MustCompile(`(?m)^version\s*=\s*"([^"]+)"`)
+)
+
+// ParseCargoLock extracts every locked package name/version pair from a
+// Cargo.lock file.
+func ParseCargoLock(path string) ([]LockedCrate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	text := string(data)
+
+	// Slice the file into one body per [[package]] header (everything up
+	// to the next header, or EOF) instead of a single regexp spanning
+	// "the next [[package]] or end of file": RE2 has no lookahead, and a
+	// pattern that consumes the next header as part of the current
+	// match's terminator skips every other package when used with
+	// FindAllStringSubmatch.
+	headers := lockPackageHeaderPattern.FindAllStringIndex(text, -1)
+
+	var crates []LockedCrate
+	for i, h := range headers {
+		end := len(text)
+		if i+1 < len(headers) {
+			end = headers[i+1][0]
+		}
+		body := text[h[1]:end]
+
+		name := ""
+		version := ""
+		if m := lockNamePattern.FindStringSubmatch(body); m != nil {
+			name = m[1]
+		}
+		if m := lockVersionPattern.FindStringSubmatch(body); m != nil {
+			version = m[1]
+		}
+		if name != "" {
+			crates = append(crates, LockedCrate{Name: name, Version: version})
+		}
+	}
+	return crates, nil
+}
+
+// Finding is one advisory matched against a locked crate, with
+// reachability resolved against the crate's own source.
+type Finding struct {
+	Advisory   Advisory
+	Crate      LockedCrate
+	Reachable  bool
+	ReachedVia []string
+}
+
+// Scanner matches an AdvisoryDB against a crate tree's Cargo.lock and
+// source files.
+type Scanner struct {
+	Root string
+	DB   *AdvisoryDB
+}
+
+// NewScanner returns a scanner rooted at root using db.
+func NewScanner(root string, db *AdvisoryDB) *Scanner {
+	return &Scanner{Root: root, DB: db}
+}
+
+// Run parses Cargo.lock, matches it against the advisory DB, and resolves
+// reachability by scanning the crate's .rs files for path usages that hit
+// an advisory's AffectedPaths.
+func (s *Scanner) Run() ([]Finding, error) {
+	lockPath := s.Root + "/Cargo.lock"
+	crates, err := ParseCargoLock(lockPath)
+	if err != nil {
+		return nil, err
+	}
+
+	byPackage := map[string][]Advisory{}
+	for _, adv := range s.DB.Advisories {
+		byPackage[adv.Package] = append(byPackage[adv.Package], adv)
+	}
+
+	var findings []Finding
+	for _, c := range crates {
+		for _, adv := range byPackage[c.Name] {
+			if isPatched(c.Version, adv.PatchedVersions) {
+				continue
+			}
+			findings = append(findings, Finding{Advisory: adv, Crate: c})
+		}
+	}
+	if len(findings) == 0 {
+		return findings, nil
+	}
+
+	usages, err := s.collectPathUsages()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range findings {
+		adv := findings[i].Advisory
+		for _, path := range adv.AffectedPaths {
+			for _, usage := range usages {
+				if usage == path || strings.HasPrefix(usage, path+"::") {
+					findings[i].Reachable = true
+					findings[i].ReachedVia = append(findings[i].ReachedVia, usage)
+				}
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+func (s *Scanner) collectPathUsages() ([]string, error) {
+	var all []string
+	err := walkRustFiles(s.Root, func(path string, contents string) {
+		for _, usage := range rustast.FindPathUsages(contents) {
+			all = append(all, usage.Path)
+		}
+	})
+	return all, err
+}
+
+// walkRustFiles calls fn with the contents of every .rs file under root,
+// skipping target/ and .git like the rest of the PUNCH analyzers do.
+func walkRustFiles(root string, fn func(path, contents string)) error {
+	return filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == "target" || d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".rs") {
+			return nil
+		}
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		fn(path, string(contents))
+		return nil
+	})
+}
+
+func isPatched(version string, patched []string) bool {
+	for _, p := range patched {
+		if p == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package vulns
+
+import (
+	"os"
+	"testing"
+)
+
+func TestIsPatched(t *testing.T) {
+	if !isPatched("1.2.3", []string{"1.2.3", "1.3.0"}) {
+		t.Fatalf("1.2.3 should be recognized as patched")
+	}
+	if isPatched("1.2.2", []string{"1.2.3", "1.3.0"}) {
+		t.Fatalf("1.2.2 should not be recognized as patched")
+	}
+}
+
+func TestParseCargoLock(t *testing.T) {
+	path := t.TempDir() + "/Cargo.lock"
+	lock := `# This file is automatically generated
+[[package]]
+name = "tokio"
+version = "1.35.0"
+
+[[package]]
+name = "serde"
+version = "1.0.196"
+`
+	if err := os.WriteFile(path, []byte(lock), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	crates, err := ParseCargoLock(path)
+	if err != nil {
+		t.Fatalf("ParseCargoLock: %v", err)
+	}
+	if len(crates) != 2 {
+		t.Fatalf("want 2 locked crates, got %d: %+v", len(crates), crates)
+	}
+	if crates[0].Name != "tokio" || crates[0].Version != "1.35.0" {
+		t.Fatalf("unexpected first crate: %+v", crates[0])
+	}
+}
+
+func TestScannerRunFindsReachableAdvisoryThroughGroupedImport(t *testing.T) {
+	dir := t.TempDir()
+	lock := `[[package]]
+name = "tokio"
+version = "1.35.0"
+`
+	if err := os.WriteFile(dir+"/Cargo.lock", []byte(lock), 0o644); err != nil {
+		t.Fatalf("WriteFile Cargo.lock: %v", err)
+	}
+	src := `use tokio::net::{TcpStream, TcpListener};
+
+async fn connect() {
+    TcpStream::connect("127.0.0.1:0").await.unwrap();
+}
+`
+	if err := os.WriteFile(dir+"/main.rs", []byte(src), 0o644); err != nil {
+		t.Fatalf("WriteFile main.rs: %v", err)
+	}
+
+	db := &AdvisoryDB{Advisories: []Advisory{{
+		ID:            "RUSTSEC-2024-0001",
+		Package:       "tokio",
+		AffectedPaths: []string{"tokio::net::TcpStream"},
+	}}}
+
+	findings, err := NewScanner(dir, db).Run()
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(findings) != 1 {
+		t.Fatalf("want 1 finding, got %d: %+v", len(findings), findings)
+	}
+	if !findings[0].Reachable {
+		t.Fatalf("want the advisory to be reachable through the grouped import, got %+v", findings[0])
+	}
+}